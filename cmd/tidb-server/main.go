@@ -25,6 +25,9 @@ import (
 	"strings"
 	"sync/atomic"
 	"time"
+	// Embed the IANA time zone database so that CONVERT_TZ can resolve named
+	// zones even when the host has no zoneinfo installed.
+	_ "time/tzdata"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/pingcap/errors"
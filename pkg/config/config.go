@@ -198,6 +198,7 @@ type Config struct {
 	Status                     Status                  `toml:"status" json:"status"`
 	Performance                Performance             `toml:"performance" json:"performance"`
 	PreparedPlanCache          PreparedPlanCache       `toml:"prepared-plan-cache" json:"prepared-plan-cache"`
+	QueryResultCache           QueryResultCache        `toml:"query-result-cache" json:"query-result-cache"`
 	OpenTracing                OpenTracing             `toml:"opentracing" json:"opentracing"`
 	ProxyProtocol              ProxyProtocol           `toml:"proxy-protocol" json:"proxy-protocol"`
 	PDClient                   tikvcfg.PDClient        `toml:"pd-client" json:"pd-client"`
@@ -763,6 +764,41 @@ type PreparedPlanCache struct {
 	MemoryGuardRatio float64 `toml:"memory-guard-ratio" json:"memory-guard-ratio"`
 }
 
+// QueryResultCache is the QueryResultCache section of the config.
+// It caches the results of repeated identical read-only queries for a short
+// period, and is disabled by default. A cached result is dropped once a table
+// it reads is written through this TiDB instance, but writes through other TiDB
+// instances are not observed, so those are only bounded by the TTL.
+type QueryResultCache struct {
+	Enabled  bool `toml:"enabled" json:"enabled"`
+	Capacity uint `toml:"capacity" json:"capacity"`
+	// MemoryLimit is the maximum memory usage in bytes of all cached results.
+	MemoryLimit uint64 `toml:"memory-limit" json:"memory-limit"`
+	// TTL is how long a cached result is kept, e.g. "1s".
+	TTL string `toml:"ttl" json:"ttl"`
+}
+
+// Valid validates QueryResultCache configs.
+func (c *QueryResultCache) Valid() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Capacity < 1 {
+		return errors.New("query-result-cache.capacity should be at least 1")
+	}
+	if c.MemoryLimit < 1 || c.MemoryLimit > math.MaxInt64 {
+		return errors.New("query-result-cache.memory-limit should be positive")
+	}
+	ttl, err := time.ParseDuration(c.TTL)
+	if err != nil {
+		return fmt.Errorf("invalid query-result-cache.ttl %q: %v", c.TTL, err)
+	}
+	if ttl <= 0 {
+		return errors.New("query-result-cache.ttl should be positive")
+	}
+	return nil
+}
+
 // OpenTracing is the opentracing section of the config.
 type OpenTracing struct {
 	Enable     bool                `toml:"enable" json:"enable"`
@@ -1025,6 +1061,12 @@ var defaultConf = Config{
 		Capacity:         100,
 		MemoryGuardRatio: 0.1,
 	},
+	QueryResultCache: QueryResultCache{
+		Enabled:     false,
+		Capacity:    100,
+		MemoryLimit: 64 << 20, // 64MB
+		TTL:         "1s",
+	},
 	OpenTracing: OpenTracing{
 		Enable: false,
 		Sampler: OpenTracingSampler{
@@ -1358,6 +1400,9 @@ func (c *Config) Valid() error {
 	if err := c.TrxSummary.Valid(); err != nil {
 		return err
 	}
+	if err := c.QueryResultCache.Valid(); err != nil {
+		return err
+	}
 
 	if c.Performance.TxnTotalSizeLimit > 1<<40 {
 		return fmt.Errorf("txn-total-size-limit should be less than %d", 1<<40)
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -1305,6 +1306,34 @@ func TestStatsLoadLimit(t *testing.T) {
 	checkQueueSizeValid(DefMaxOfStatsLoadQueueSizeLimit+1, false)
 }
 
+func TestQueryResultCacheValid(t *testing.T) {
+	conf := NewConfig()
+	require.False(t, conf.QueryResultCache.Enabled)
+	tests := []struct {
+		enabled  bool
+		capacity uint
+		memLimit uint64
+		ttl      string
+		valid    bool
+	}{
+		{false, 0, 0, "", true},
+		{true, 100, 1 << 20, "1s", true},
+		{true, 1, 1, "500ms", true},
+		{true, 0, 1 << 20, "1s", false},
+		{true, 100, 0, "1s", false},
+		{true, 100, math.MaxUint64, "1s", false},
+		{true, 100, 1 << 20, "", false},
+		{true, 100, 1 << 20, "abc", false},
+		{true, 100, 1 << 20, "0s", false},
+		{true, 100, 1 << 20, "-1s", false},
+	}
+
+	for _, tt := range tests {
+		conf.QueryResultCache = QueryResultCache{Enabled: tt.enabled, Capacity: tt.capacity, MemoryLimit: tt.memLimit, TTL: tt.ttl}
+		require.Equal(t, tt.valid, conf.Valid() == nil, "%+v", tt)
+	}
+}
+
 func TestGetGlobalKeyspaceName(t *testing.T) {
 	conf := NewConfig()
 	require.Empty(t, conf.KeyspaceName)
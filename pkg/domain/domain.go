@@ -84,6 +84,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tidb/pkg/util/memoryusagealarm"
 	"github.com/pingcap/tidb/pkg/util/replayer"
+	"github.com/pingcap/tidb/pkg/util/resultcache"
 	"github.com/pingcap/tidb/pkg/util/servermemorylimit"
 	"github.com/pingcap/tidb/pkg/util/sqlexec"
 	"github.com/pingcap/tidb/pkg/util/sqlkiller"
@@ -165,6 +166,7 @@ type Domain struct {
 	dumpFileGcChecker   *dumpFileGcChecker
 	planReplayerHandle  *planReplayerHandle
 	extractTaskHandle   *ExtractHandle
+	queryResultCache    *resultcache.Cache
 	expiredTimeStamp4PC struct {
 		// let `expiredTimeStamp4PC` use its own lock to avoid any block across domain.Reload()
 		// and compiler.Compile(), see issue https://github.com/pingcap/tidb/issues/45400
@@ -1099,6 +1101,11 @@ func NewDomain(store kv.Storage, ddlLease time.Duration, statsLease time.Duratio
 	do.sysProcesses = SysProcesses{mu: &sync.RWMutex{}, procMap: make(map[uint64]sessionctx.Context)}
 	do.initDomainSysVars()
 	do.expiredTimeStamp4PC.expiredTimeStamp = types.NewTime(types.ZeroCoreTime, mysql.TypeTimestamp, types.DefaultFsp)
+	if cfg := config.GetGlobalConfig().QueryResultCache; cfg.Enabled {
+		// The config has been validated when it is loaded.
+		ttl, _ := time.ParseDuration(cfg.TTL)
+		do.queryResultCache = resultcache.New(int(cfg.Capacity), int64(cfg.MemoryLimit), ttl)
+	}
 	return do
 }
 
@@ -2514,6 +2521,11 @@ func (do *Domain) ExpensiveQueryHandle() *expensivequery.Handle {
 	return do.expensiveQueryHandle
 }
 
+// QueryResultCache returns the query result cache, or nil if it is disabled.
+func (do *Domain) QueryResultCache() *resultcache.Cache {
+	return do.queryResultCache
+}
+
 // MemoryUsageAlarmHandle returns the memory usage alarm handle.
 func (do *Domain) MemoryUsageAlarmHandle() *memoryusagealarm.Handle {
 	return do.memoryUsageAlarmHandle
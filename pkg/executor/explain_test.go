@@ -432,6 +432,39 @@ func TestExplainJSON(t *testing.T) {
 			require.NotEqual(t, flatJSONRows[i].DiskInfo, "")
 		}
 	}
+
+	// explain format = 'json' shows the cost like the verbose format
+	resJSON := tk.MustQuery("explain format = 'JSON' select * from t1").Rows()
+	j := new([]*plannercore.ExplainInfoForEncode)
+	require.NoError(t, json.Unmarshal([]byte(resJSON[0][0].(string)), j))
+	for _, row := range *j {
+		for _, flatRow := range flatJSONPlan(row) {
+			require.NotEmpty(t, flatRow.EstCost)
+		}
+	}
+	for _, sql := range cases {
+		jsonFormat := "explain format = 'json' " + sql
+		verboseFormat := "explain format = verbose " + sql
+		resJSON := tk.MustQuery(jsonFormat).Rows()
+		resRow := tk.MustQuery(verboseFormat).Rows()
+
+		j := new([]*plannercore.ExplainInfoForEncode)
+		require.NoError(t, json.Unmarshal([]byte(resJSON[0][0].(string)), j))
+		var flatJSONRows []*plannercore.ExplainInfoForEncode
+		for _, row := range *j {
+			flatJSONRows = append(flatJSONRows, flatJSONPlan(row)...)
+		}
+		require.Equal(t, len(flatJSONRows), len(resRow))
+
+		for i, row := range resRow {
+			require.Contains(t, row[0], flatJSONRows[i].ID)
+			require.Equal(t, flatJSONRows[i].EstRows, row[1])
+			require.Equal(t, flatJSONRows[i].EstCost, row[2])
+			require.Equal(t, flatJSONRows[i].TaskType, row[3])
+			require.Equal(t, flatJSONRows[i].AccessObject, row[4])
+			require.Equal(t, flatJSONRows[i].OperatorInfo, row[5])
+		}
+	}
 }
 
 func TestExplainFormatInCtx(t *testing.T) {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
 	"testing"
@@ -597,3 +598,26 @@ func newGetTiFlashSystemTableRequestMocker(t *testing.T) *getTiFlashSystemTableR
 		t:        t,
 	}
 }
+
+func TestGlobalVariables(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("set @@global.tidb_mem_quota_query = 123456789")
+	tk.MustExec("set @@session.tidb_mem_quota_query = 1")
+	tk.MustQuery("select variable_value from information_schema.global_variables where variable_name = 'tidb_mem_quota_query'").Check(testkit.Rows("123456789"))
+	// Session only variables are not listed.
+	tk.MustQuery("select count(*) from information_schema.global_variables where variable_name = 'warning_count'").Check(testkit.Rows("0"))
+	tk.MustQuery("select variable_value from information_schema.global_variables where variable_name = 'tidb_enable_noop_functions'").Check(testkit.Rows("OFF"))
+	// Read-only variables are listed.
+	tk.MustQuery("select variable_value from information_schema.global_variables where variable_name = 'version_compile_os'").Check(testkit.Rows(runtime.GOOS))
+	// The rows are the same as the ones of SHOW GLOBAL VARIABLES, including the noop variables or not.
+	for _, enableNoop := range []string{"ON", "OFF"} {
+		tk.MustExec("set @@global.tidb_enable_noop_variables = " + enableNoop)
+		expected := tk.MustQuery("show global variables").Sort().Rows()
+		tk.MustQuery("select variable_name, variable_value from information_schema.global_variables").Sort().Check(expected)
+		// The values of timestamp and last_sql_use_alloc change with every statement.
+		expected = tk.MustQuery("show session variables where variable_name not in ('timestamp', 'last_sql_use_alloc')").Sort().Rows()
+		tk.MustQuery("select variable_name, variable_value from information_schema.session_variables where variable_name not in ('timestamp', 'last_sql_use_alloc')").Sort().Check(expected)
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/metrics"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/format"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/sessiontxn/staleread"
+	util2 "github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/resultcache"
+)
+
+// nonDeterministicFunctions stores functions which make the result of a query
+// unsuitable for the query result cache, besides the ones that are illegal for
+// generated columns.
+var nonDeterministicFunctions = map[string]struct{}{
+	ast.UUIDShort:            {},
+	ast.CurrentRole:          {},
+	ast.CurrentResourceGroup: {},
+	ast.TiDBCurrentTso:       {},
+	ast.NextVal:              {},
+	ast.LastVal:              {},
+	ast.SetVal:               {},
+}
+
+// resultCacheSessionVars stores the system variables whose session values
+// change the result of a query, besides the ones in the plan cache key.
+var resultCacheSessionVars = []string{
+	variable.GroupConcatMaxLen,
+	"div_precision_increment",
+}
+
+// buildExecutorWithResultCache builds the executor of a. If the statement can
+// use the query result cache, the result is served from the cache on a hit, or
+// stored into the cache once it is drained on a miss.
+func (a *ExecStmt) buildExecutorWithResultCache() (exec.Executor, error) {
+	cache := domain.GetDomain(a.Ctx).QueryResultCache()
+	if cache == nil {
+		return a.buildExecutor()
+	}
+	key, tableIDs, ok := a.queryResultCacheKey()
+	if !ok {
+		return a.buildExecutor()
+	}
+	schema := a.Plan.Schema()
+	if rows, hit := cache.Get(key); hit && rows.NumCols() == schema.Len() {
+		metrics.QueryResultCacheCounter.WithLabelValues("hit").Inc()
+		return &cachedResultExec{
+			BaseExecutor: exec.NewBaseExecutor(a.Ctx, schema, 0),
+			rows:         rows,
+		}, nil
+	}
+	metrics.QueryResultCacheCounter.WithLabelValues("miss").Inc()
+
+	// The epoch is read before the executor is built, so a write committed after
+	// the snapshot of this statement is fetched prevents the result from being
+	// stored. A write committed while the statement is being compiled is only
+	// bounded by the TTL, since the timestamp may have been requested before.
+	epoch := cache.Epoch()
+	e, err := a.buildExecutor()
+	if err != nil {
+		return nil, err
+	}
+	return &resultCacheFillExec{
+		BaseExecutor: exec.NewBaseExecutor(a.Ctx, e.Schema(), 0, e),
+		cache:        cache,
+		key:          key,
+		epoch:        epoch,
+		tableIDs:     tableIDs,
+	}, nil
+}
+
+// queryResultCacheKey returns the key of the statement in the query result
+// cache, and the physical IDs of the tables it reads. Only read-only
+// autocommit SELECT statements on normal tables whose result only depends on
+// the data can use the cache.
+func (a *ExecStmt) queryResultCacheKey() (resultcache.Key, []int64, bool) {
+	sel, ok := a.StmtNode.(*ast.SelectStmt)
+	if !ok || sel.LockInfo != nil || sel.SelectIntoOpt != nil {
+		return resultcache.Key{}, nil, false
+	}
+	vars := a.Ctx.GetSessionVars()
+	if vars.InRestrictedSQL || vars.InTxn() || !vars.IsAutocommit() ||
+		vars.SnapshotTS != 0 || staleread.IsStmtStaleness(a.Ctx) {
+		return resultcache.Key{}, nil, false
+	}
+
+	checker := &resultCacheChecker{cacheable: true}
+	sel.Accept(checker)
+	if !checker.cacheable || len(checker.tableIDs) == 0 {
+		return resultcache.Key{}, nil, false
+	}
+
+	var sb strings.Builder
+	// The result also depends on the session state below, which is not part of
+	// the SQL text. It's the same as the one in the key of the plan cache, e.g.
+	// sql_select_limit is applied to the plan by the optimizer.
+	connCharset, connCollation := vars.GetCharsetInfo()
+	fmt.Fprintf(&sb, "%s\n%d\n%s\n%d\n%s\n%s\n", vars.CurrentDB, vars.SQLMode, vars.Location().String(),
+		vars.SelectLimit, connCharset, connCollation)
+	for _, name := range resultCacheSessionVars {
+		value, _ := vars.GetSystemVar(name)
+		fmt.Fprintf(&sb, "%s\n", value)
+	}
+	if err := sel.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return resultcache.Key{}, nil, false
+	}
+	key := resultcache.Key{
+		SQLDigest:     parser.DigestNormalized(sb.String()).String(),
+		SchemaVersion: a.InfoSchema.SchemaMetaVersion(),
+	}
+	return key, checker.tableIDs, true
+}
+
+// resultCacheChecker checks whether a SELECT statement can use the query
+// result cache, and collects the physical IDs of the tables it reads.
+type resultCacheChecker struct {
+	cacheable bool
+	tableIDs  []int64
+}
+
+// Enter implements ast.Visitor interface.
+func (c *resultCacheChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch node := in.(type) {
+	case *ast.VariableExpr, ast.ParamMarkerExpr:
+		c.cacheable = false
+	case *ast.FuncCallExpr:
+		if _, ok := expression.IllegalFunctions4GeneratedColumns[node.FnName.L]; ok {
+			c.cacheable = false
+		} else if _, ok := nonDeterministicFunctions[node.FnName.L]; ok {
+			c.cacheable = false
+		}
+	case *ast.TableName:
+		c.checkTable(node)
+	}
+	return in, !c.cacheable
+}
+
+func (c *resultCacheChecker) checkTable(tn *ast.TableName) {
+	if tn.AsOf != nil {
+		c.cacheable = false
+		return
+	}
+	tblInfo := tn.TableInfo
+	if tblInfo == nil {
+		// A reference to a CTE is not resolved to a table.
+		if tn.Schema.L != "" {
+			c.cacheable = false
+		}
+		return
+	}
+	// The base tables of a view are not resolved in the AST, and writes to
+	// memory tables and temporary tables are not tracked by committed
+	// transactions.
+	if util2.IsMemOrSysDB(tn.Schema.L) || tblInfo.IsView() || tblInfo.IsSequence() ||
+		tblInfo.TempTableType != model.TempTableNone {
+		c.cacheable = false
+		return
+	}
+	c.tableIDs = append(c.tableIDs, tblInfo.ID)
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		for _, def := range pi.Definitions {
+			c.tableIDs = append(c.tableIDs, def.ID)
+		}
+	}
+}
+
+// Leave implements ast.Visitor interface.
+func (c *resultCacheChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, c.cacheable
+}
+
+// cachedResultExec returns the rows of a query from the query result cache.
+type cachedResultExec struct {
+	exec.BaseExecutor
+
+	// rows is shared with the cache and must not be modified.
+	rows   *chunk.Chunk
+	cursor int
+}
+
+// Next implements the Executor Next interface.
+func (e *cachedResultExec) Next(_ context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.cursor >= e.rows.NumRows() {
+		return nil
+	}
+	numCurBatch := min(req.Capacity(), e.rows.NumRows()-e.cursor)
+	req.Append(e.rows, e.cursor, e.cursor+numCurBatch)
+	e.cursor += numCurBatch
+	return nil
+}
+
+// resultCacheFillExec returns the rows of its child, and stores them into the
+// query result cache once the child is drained without error.
+type resultCacheFillExec struct {
+	exec.BaseExecutor
+
+	cache    *resultcache.Cache
+	key      resultcache.Key
+	epoch    uint64
+	tableIDs []int64
+	// rows is nil once the result turns out to be not cacheable.
+	rows *chunk.Chunk
+	done bool
+}
+
+// Open implements the Executor Open interface.
+func (e *resultCacheFillExec) Open(ctx context.Context) error {
+	e.rows = chunk.NewChunkWithCapacity(exec.RetTypes(e), e.InitCap())
+	return e.BaseExecutor.Open(ctx)
+}
+
+// Next implements the Executor Next interface.
+func (e *resultCacheFillExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	if err := exec.Next(ctx, e.Children(0), req); err != nil {
+		e.rows = nil
+		return err
+	}
+	if e.rows == nil || e.done {
+		return nil
+	}
+	if req.NumRows() == 0 {
+		e.done = true
+		// The warnings of the statement can not be returned from the cache.
+		if e.Ctx().GetSessionVars().StmtCtx.WarningCount() == 0 {
+			e.cache.Put(e.key, e.epoch, e.tableIDs, e.rows)
+		}
+		e.rows = nil
+		return nil
+	}
+	if e.rows.NumRows()+req.NumRows() > resultcache.MaxRows {
+		e.rows = nil
+		return nil
+	}
+	e.rows.Append(req, 0, req.NumRows())
+	if e.rows.MemoryUsage() > e.cache.EntryMemoryLimit() {
+		e.rows = nil
+	}
+	return nil
+}
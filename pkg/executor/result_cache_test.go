@@ -0,0 +1,110 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/config"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/metrics"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryResultCache(t *testing.T) {
+	defer config.RestoreFunc()()
+	config.UpdateGlobal(func(conf *config.Config) {
+		conf.QueryResultCache.Enabled = true
+		conf.QueryResultCache.Capacity = 10
+		conf.QueryResultCache.TTL = "1m"
+	})
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	cache := domain.GetDomain(tk.Session()).QueryResultCache()
+	require.NotNil(t, cache)
+
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("create table t2 (a int)")
+	tk.MustExec("insert into t values (1, 10), (2, 20)")
+	tk.MustExec("insert into t2 values (1)")
+
+	readCounter := func(counter prometheus.Counter) float64 {
+		var metric dto.Metric
+		require.Nil(t, counter.Write(&metric))
+		return metric.Counter.GetValue()
+	}
+	checkQuery := func(q string, hit, miss int, result ...string) {
+		beforeHit := readCounter(metrics.QueryResultCacheCounter.WithLabelValues("hit"))
+		beforeMiss := readCounter(metrics.QueryResultCacheCounter.WithLabelValues("miss"))
+		tk.MustQuery(q).Sort().Check(testkit.Rows(result...))
+		afterHit := readCounter(metrics.QueryResultCacheCounter.WithLabelValues("hit"))
+		afterMiss := readCounter(metrics.QueryResultCacheCounter.WithLabelValues("miss"))
+		require.Equal(t, hit, int(afterHit-beforeHit), "exec query '%s' check hit failed", q)
+		require.Equal(t, miss, int(afterMiss-beforeMiss), "exec query '%s' check miss failed", q)
+	}
+
+	// A repeated query hits the cache and returns the same rows.
+	checkQuery("select * from t", 0, 1, "1 10", "2 20")
+	require.Equal(t, 1, cache.Len())
+	checkQuery("select * from t", 1, 0, "1 10", "2 20")
+	// Queries with different literals are different entries.
+	checkQuery("select * from t where a = 1", 0, 1, "1 10")
+	checkQuery("select * from t where a = 2", 0, 1, "2 20")
+	checkQuery("select * from t where a = 2", 1, 0, "2 20")
+	checkQuery("select * from t2", 0, 1, "1")
+	require.Equal(t, 4, cache.Len())
+
+	// A write to t invalidates the results reading t only.
+	tk.MustExec("insert into t values (3, 30)")
+	require.Equal(t, 1, cache.Len())
+	checkQuery("select * from t", 0, 1, "1 10", "2 20", "3 30")
+	checkQuery("select * from t", 1, 0, "1 10", "2 20", "3 30")
+	checkQuery("select * from t2", 1, 0, "1")
+
+	// A write in an explicit transaction invalidates the result once committed,
+	// and reads in a transaction do not use the cache.
+	tk.MustExec("begin")
+	tk.MustExec("update t set b = 11 where a = 1")
+	checkQuery("select * from t", 0, 0, "1 11", "2 20", "3 30")
+	tk.MustExec("commit")
+	checkQuery("select * from t", 0, 1, "1 11", "2 20", "3 30")
+
+	// A schema change makes the old results never hit again.
+	tk.MustExec("alter table t add column c int")
+	checkQuery("select * from t", 0, 1, "1 11 <nil>", "2 20 <nil>", "3 30 <nil>")
+
+	// The session variables which change the result are part of the key.
+	checkQuery("select a from t order by a", 0, 1, "1", "2", "3")
+	tk.MustExec("set @@sql_select_limit = 1")
+	checkQuery("select a from t order by a", 0, 1, "1")
+	checkQuery("select a from t order by a", 1, 0, "1")
+	tk.MustExec("set @@sql_select_limit = default")
+	checkQuery("select a from t order by a", 1, 0, "1", "2", "3")
+	tk.MustExec("set @@collation_connection = utf8mb4_bin")
+	checkQuery("select count(*) from t where 'a' = 'A'", 0, 1, "0")
+	tk.MustExec("set @@collation_connection = utf8mb4_general_ci")
+	checkQuery("select count(*) from t where 'a' = 'A'", 0, 1, "3")
+	tk.MustExec("set @@collation_connection = default")
+
+	// Non-deterministic queries and queries reading memory tables are not cached.
+	checkQuery("select count(*) from t where rand() >= 0", 0, 0, "3")
+	checkQuery("select a from t where a > @@auto_increment_increment", 0, 0, "2", "3")
+	checkQuery("select count(*) from information_schema.tables where table_name = 't2'", 0, 0, "1")
+	checkQuery("select a from t where a > 100 for update", 0, 0)
+}
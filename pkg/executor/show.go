@@ -861,24 +861,22 @@ func (e *ShowExec) fetchShowVariables(ctx context.Context) (err error) {
 		// 2. If the variable is ScopeNone, it's a read-only variable, return the default value of it,
 		// 		otherwise, fetch the value from table `mysql.Global_Variables`.
 		for _, v := range variable.GetSysVars() {
-			if v.Scope != variable.ScopeSession {
-				if v.IsNoop && !variable.EnableNoopVariables.Load() {
-					continue
-				}
-				if fieldFilter != "" && v.Name != fieldFilter {
-					continue
-				} else if fieldPatternsLike != nil && !fieldPatternsLike.DoMatch(v.Name) {
-					continue
-				}
-				if infoschema.SysVarHiddenForSem(e.Ctx(), v.Name) {
-					continue
-				}
-				value, err = sessionVars.GetGlobalSystemVar(ctx, v.Name)
-				if err != nil {
-					return errors.Trace(err)
-				}
-				e.appendRow([]any{v.Name, value})
+			if !infoschema.SysVarListedAsGlobal(v) {
+				continue
+			}
+			if fieldFilter != "" && v.Name != fieldFilter {
+				continue
+			} else if fieldPatternsLike != nil && !fieldPatternsLike.DoMatch(v.Name) {
+				continue
+			}
+			if infoschema.SysVarHiddenForSem(e.Ctx(), v.Name) {
+				continue
+			}
+			value, err = sessionVars.GetGlobalSystemVar(ctx, v.Name)
+			if err != nil {
+				return errors.Trace(err)
 			}
+			e.appendRow([]any{v.Name, value})
 		}
 		return nil
 	}
@@ -887,7 +885,7 @@ func (e *ShowExec) fetchShowVariables(ctx context.Context) (err error) {
 	// If it is a session only variable, use the default value defined in code,
 	//   otherwise, fetch the value from table `mysql.Global_Variables`.
 	for _, v := range variable.GetSysVars() {
-		if v.IsNoop && !variable.EnableNoopVariables.Load() {
+		if !infoschema.SysVarListedAsSession(v) {
 			continue
 		}
 		if fieldFilter != "" && v.Name != fieldFilter {
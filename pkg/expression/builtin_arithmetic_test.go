@@ -187,6 +187,41 @@ func TestArithmeticPlus(t *testing.T) {
 	require.Equal(t, int64(4), intResult)
 }
 
+func TestArithmeticTemporalWithNumeric(t *testing.T) {
+	ctx := createContext(t)
+	date := types.NewTime(types.FromDate(2024, 1, 15, 0, 0, 0, 0), mysql.TypeDate, 0)
+	datetime := types.NewTime(types.FromDate(2024, 1, 15, 10, 30, 0, 0), mysql.TypeDatetime, 0)
+	datetimeFsp := types.NewTime(types.FromDate(2024, 1, 15, 10, 30, 0, 500000), mysql.TypeDatetime, 1)
+	duration := types.Duration{Duration: 10*time.Hour + 30*time.Minute}
+	// Arithmetic between a temporal value and a plain number operates on the
+	// numeric form of the temporal value, e.g. DATE '2024-01-15' + 1 = 20240116.
+	testCases := []struct {
+		funcName string
+		args     []any
+		expect   any
+	}{
+		{ast.Plus, []any{date, int64(1)}, int64(20240116)},
+		{ast.Plus, []any{int64(1), date}, int64(20240116)},
+		{ast.Minus, []any{date, int64(15)}, int64(20240100)},
+		{ast.Plus, []any{date, 1.5}, 20240116.5},
+		{ast.Plus, []any{datetime, int64(1)}, int64(20240115103001)},
+		{ast.Plus, []any{datetimeFsp, int64(1)}, types.NewDecFromStringForTest("20240115103001.5")},
+		{ast.Plus, []any{duration, int64(1)}, int64(103001)},
+		{ast.Minus, []any{duration, int64(1)}, int64(102999)},
+		{ast.Mul, []any{duration, int64(2)}, int64(206000)},
+		{ast.Plus, []any{date, nil}, nil},
+		{ast.Plus, []any{nil, duration}, nil},
+	}
+
+	for _, tc := range testCases {
+		f, err := funcs[tc.funcName].getFunction(ctx, primitiveValsToConstants(ctx, tc.args))
+		require.NoError(t, err)
+		val, err := evalBuiltinFunc(f, ctx, chunk.Row{})
+		require.NoError(t, err)
+		testutil.DatumEqual(t, types.NewDatum(tc.expect), val, "%s%v", tc.funcName, tc.args)
+	}
+}
+
 func TestArithmeticMinus(t *testing.T) {
 	ctx := createContext(t)
 	// case: 1
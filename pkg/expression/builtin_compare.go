@@ -605,7 +605,20 @@ func (b *builtinGreatestDecimalSig) evalDecimal(ctx EvalContext, row chunk.Row)
 			max = v
 		}
 	}
-	return
+	return fixDecimalFracForGreatestAndLeast(max, b.tp.GetDecimal())
+}
+
+// fixDecimalFracForGreatestAndLeast pads the fraction of the selected value
+// to the scale of the return type, so GREATEST(3, 2.5) returns 3.0 like MySQL.
+func fixDecimalFracForGreatestAndLeast(d *types.MyDecimal, frac int) (*types.MyDecimal, bool, error) {
+	if int(d.GetDigitsFrac()) >= frac {
+		return d, false, nil
+	}
+	res := new(types.MyDecimal)
+	if err := d.Round(res, frac, types.ModeHalfUp); err != nil {
+		return nil, true, err
+	}
+	return res, false, nil
 }
 
 type builtinGreatestStringSig struct {
@@ -903,7 +916,7 @@ func (b *builtinLeastDecimalSig) evalDecimal(ctx EvalContext, row chunk.Row) (mi
 			min = v
 		}
 	}
-	return
+	return fixDecimalFracForGreatestAndLeast(min, b.tp.GetDecimal())
 }
 
 type builtinLeastStringSig struct {
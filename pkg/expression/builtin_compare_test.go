@@ -404,6 +404,47 @@ func TestGreatestLeastFunc(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGreatestLeastDecimalWithInt(t *testing.T) {
+	ctx := createContext(t)
+	for _, test := range []struct {
+		args             []any
+		expectedGreatest string
+		expectedLeast    string
+	}{
+		{[]any{1, types.NewDecFromStringForTest("2.5")}, "2.5", "1.0"},
+		{[]any{3, types.NewDecFromStringForTest("2.5")}, "3.0", "2.5"},
+		{[]any{types.NewDecFromStringForTest("-1.25"), 7, types.NewDecFromStringForTest("0.5")}, "7.00", "-1.25"},
+		{[]any{uint64(10), types.NewDecFromStringForTest("9.99")}, "10.00", "9.99"},
+	} {
+		f0, err := newFunctionForTest(ctx, ast.Greatest, primitiveValsToConstants(ctx, test.args)...)
+		require.NoError(t, err)
+		f1, err := newFunctionForTest(ctx, ast.Least, primitiveValsToConstants(ctx, test.args)...)
+		require.NoError(t, err)
+		for _, f := range []Expression{f0, f1} {
+			require.Equal(t, types.ETDecimal, f.GetType().EvalType())
+			require.Equal(t, mysql.TypeNewDecimal, f.GetType().GetType())
+		}
+
+		d, err := f0.Eval(ctx, chunk.Row{})
+		require.NoError(t, err)
+		require.Equal(t, types.KindMysqlDecimal, d.Kind())
+		require.Equal(t, test.expectedGreatest, d.GetMysqlDecimal().String())
+
+		d, err = f1.Eval(ctx, chunk.Row{})
+		require.NoError(t, err)
+		require.Equal(t, types.KindMysqlDecimal, d.Kind())
+		require.Equal(t, test.expectedLeast, d.GetMysqlDecimal().String())
+
+		input := chunk.NewChunkWithCapacity([]*types.FieldType{types.NewFieldType(mysql.TypeLonglong)}, 1)
+		input.AppendInt64(0, 0)
+		result := chunk.NewColumn(f0.GetType(), 1)
+		require.NoError(t, f0.VecEvalDecimal(ctx, input, result))
+		require.Equal(t, test.expectedGreatest, result.GetDecimal(0).String())
+		require.NoError(t, f1.VecEvalDecimal(ctx, input, result))
+		require.Equal(t, test.expectedLeast, result.GetDecimal(0).String())
+	}
+}
+
 func TestRefineArgsWithCastEnum(t *testing.T) {
 	ctx := createContext(t)
 	zeroUintConst := primitiveValsToConstants(ctx, []any{uint64(0)})[0]
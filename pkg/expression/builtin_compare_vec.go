@@ -51,6 +51,20 @@ func (b *builtinGreatestDecimalSig) vecEvalDecimal(ctx EvalContext, input *chunk
 			}
 		}
 	}
+	return vecFixDecimalFracForGreatestAndLeast(result, b.tp.GetDecimal())
+}
+
+// vecFixDecimalFracForGreatestAndLeast is the vectorized version of fixDecimalFracForGreatestAndLeast.
+func vecFixDecimalFracForGreatestAndLeast(result *chunk.Column, frac int) error {
+	d64s := result.Decimals()
+	for i := range d64s {
+		if result.IsNull(i) || int(d64s[i].GetDigitsFrac()) >= frac {
+			continue
+		}
+		if err := d64s[i].Round(&d64s[i], frac, types.ModeHalfUp); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -86,7 +100,7 @@ func (b *builtinLeastDecimalSig) vecEvalDecimal(ctx EvalContext, input *chunk.Ch
 			}
 		}
 	}
-	return nil
+	return vecFixDecimalFracForGreatestAndLeast(result, b.tp.GetDecimal())
 }
 
 func (b *builtinLeastDecimalSig) vectorized() bool {
@@ -61,6 +61,39 @@ func TestLike(t *testing.T) {
 	}
 }
 
+func TestLikeNonStringOperands(t *testing.T) {
+	ctx := createContext(t)
+	date, err := types.ParseDate(types.DefaultStmtNoWarningContext, "2024-01-15")
+	require.NoError(t, err)
+	tests := []struct {
+		input   any
+		pattern any
+		match   any
+	}{
+		{123, "12%", int64(1)},
+		{123, "13%", int64(0)},
+		{-1.5, "-1._", int64(1)},
+		{uint64(18446744073709551615), "%615", int64(1)},
+		{types.NewDecFromStringForTest("3.140"), "3.14_", int64(1)},
+		{date, "2024-01-%", int64(1)},
+		{date, "2024-02-%", int64(0)},
+		{123, 12, int64(0)},
+		{123, 123, int64(1)},
+		{nil, "12%", nil},
+		{123, nil, nil},
+	}
+
+	fc := funcs[ast.Like]
+	for _, tt := range tests {
+		comment := fmt.Sprintf(`for input = "%v", pattern = "%v"`, tt.input, tt.pattern)
+		f, err := fc.getFunction(ctx, datumsToConstants(types.MakeDatums(tt.input, tt.pattern, int('\\'))))
+		require.NoError(t, err, comment)
+		r, err := evalBuiltinFuncConcurrent(f, ctx, chunk.Row{})
+		require.NoError(t, err, comment)
+		testutil.DatumEqual(t, types.NewDatum(tt.match), r, comment)
+	}
+}
+
 func TestRegexp(t *testing.T) {
 	ctx := createContext(t)
 	tests := []struct {
@@ -22,11 +22,13 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/domain/infosync"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
@@ -86,6 +88,7 @@ var (
 	_ builtinFunc = &builtinIsIPv6Sig{}
 	_ builtinFunc = &builtinIsUUIDSig{}
 	_ builtinFunc = &builtinUUIDSig{}
+	_ builtinFunc = &builtinUUIDShortSig{}
 	_ builtinFunc = &builtinVitessHashSig{}
 	_ builtinFunc = &builtinUUIDToBinSig{}
 	_ builtinFunc = &builtinBinToUUIDSig{}
@@ -1307,7 +1310,57 @@ type uuidShortFunctionClass struct {
 }
 
 func (c *uuidShortFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
-	return nil, ErrFunctionNotExists.GenWithStackByArgs("FUNCTION", "UUID_SHORT")
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.AddFlag(mysql.UnsignedFlag)
+	sig := &builtinUUIDShortSig{bf}
+	return sig, nil
+}
+
+// uuidShortSeq is the lower 56 bits of the last value returned by UUID_SHORT().
+// Like MySQL, it starts from the startup time in seconds shifted left by 24 bits,
+// and is increased by 1 on each call, so the values are unique and increasing
+// across all sessions.
+var uuidShortSeq atomic.Uint64
+
+func init() {
+	uuidShortSeq.Store(uint64(time.Now().Unix()) << 24)
+}
+
+// nextUUIDShort returns the next value of UUID_SHORT(). Like MySQL puts server_id
+// into the top 8 bits, the server ID of this TiDB instance is put there to keep
+// the values of different instances apart.
+func nextUUIDShort() uint64 {
+	return uuidShortServerID()<<56 | uuidShortSeq.Add(1)&(1<<56-1)
+}
+
+func uuidShortServerID() uint64 {
+	info, err := infosync.GetServerInfo()
+	if err != nil || info.ServerIDGetter == nil {
+		return 0
+	}
+	return info.ServerIDGetter() & 0xff
+}
+
+type builtinUUIDShortSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinUUIDShortSig) Clone() builtinFunc {
+	newSig := &builtinUUIDShortSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinUUIDShortSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_uuid-short
+func (b *builtinUUIDShortSig) evalInt(ctx EvalContext, row chunk.Row) (int64, bool, error) {
+	return int64(nextUUIDShort()), false, nil
 }
 
 type vitessHashFunctionClass struct {
@@ -161,6 +161,27 @@ func TestUUID(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUUIDShort(t *testing.T) {
+	ctx := createContext(t)
+	f, err := newFunctionForTest(ctx, ast.UUIDShort)
+	require.NoError(t, err)
+	require.True(t, mysql.HasUnsignedFlag(f.GetType().GetFlag()))
+
+	// The values are increasing, and the high bits come from the startup time.
+	last := uint64(0)
+	for i := 0; i < 3; i++ {
+		d, err := f.Eval(ctx, chunk.Row{})
+		require.NoError(t, err)
+		require.Equal(t, types.KindUint64, d.Kind())
+		require.Greater(t, d.GetUint64(), last)
+		require.LessOrEqual(t, d.GetUint64()>>24, uint64(time.Now().Unix()))
+		last = d.GetUint64()
+	}
+
+	_, err = funcs[ast.UUIDShort].getFunction(ctx, datumsToConstants(types.MakeDatums(1)))
+	require.Error(t, err)
+}
+
 func TestAnyValue(t *testing.T) {
 	ctx := createContext(t)
 	tbl := []struct {
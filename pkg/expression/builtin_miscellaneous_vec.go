@@ -218,6 +218,20 @@ func (b *builtinUUIDSig) vecEvalString(ctx EvalContext, input *chunk.Chunk, resu
 	return nil
 }
 
+func (b *builtinUUIDShortSig) vectorized() bool {
+	return true
+}
+
+func (b *builtinUUIDShortSig) vecEvalInt(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
+	n := input.NumRows()
+	result.ResizeInt64(n, false)
+	i64s := result.Int64s()
+	for i := 0; i < n; i++ {
+		i64s[i] = int64(nextUUIDShort())
+	}
+	return nil
+}
+
 func (b *builtinNameConstDurationSig) vectorized() bool {
 	return true
 }
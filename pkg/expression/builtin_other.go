@@ -710,8 +710,9 @@ func (b *builtinRowSig) Clone() builtinFunc {
 }
 
 // evalString rowFunc should always be flattened in expression rewrite phrase.
+// If a row still reaches a scalar consumer, report it instead of evaluating it.
 func (b *builtinRowSig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
-	panic("builtinRowSig.evalString() should never be called.")
+	return "", true, ErrOperandColumns.GenWithStackByArgs(1)
 }
 
 type setVarFunctionClass struct {
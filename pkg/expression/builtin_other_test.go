@@ -80,6 +80,26 @@ func TestRowFunc(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRowFuncInScalarContext(t *testing.T) {
+	ctx := createContext(t)
+	rowFunc, err := newFunctionForTest(ctx, ast.RowFunc, datumsToConstants(types.MakeDatums(1, 2))...)
+	require.NoError(t, err)
+
+	_, err = rowFunc.Eval(ctx, chunk.Row{})
+	require.True(t, ErrOperandColumns.Equal(err), "%v", err)
+
+	plus, err := newFunctionForTest(ctx, ast.Plus, rowFunc, NewOne())
+	require.NoError(t, err)
+	_, err = plus.Eval(ctx, chunk.Row{})
+	require.True(t, ErrOperandColumns.Equal(err), "%v", err)
+
+	input := chunk.NewChunkWithCapacity([]*types.FieldType{types.NewFieldType(mysql.TypeLonglong)}, 1)
+	input.AppendInt64(0, 1)
+	result := chunk.NewColumn(types.NewFieldType(mysql.TypeVarString), 1)
+	err = rowFunc.(*ScalarFunction).Function.vecEvalString(ctx, input, result)
+	require.True(t, ErrOperandColumns.Equal(err), "%v", err)
+}
+
 func TestSetVar(t *testing.T) {
 	ctx := createContext(t)
 	fc := funcs[ast.SetVar]
@@ -44,7 +44,7 @@ func (b *builtinRowSig) vectorized() bool {
 }
 
 func (b *builtinRowSig) vecEvalString(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
-	panic("builtinRowSig.vecEvalString() should never be called.")
+	return ErrOperandColumns.GenWithStackByArgs(1)
 }
 
 func (b *builtinValuesRealSig) vectorized() bool {
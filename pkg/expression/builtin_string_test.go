@@ -928,6 +928,18 @@ func TestSubstringIndex(t *testing.T) {
 		{[]any{"", ".", 0}, false, false, ""},
 		{[]any{"", ".", 1}, false, false, ""},
 		{[]any{"", ".", -1}, false, false, ""},
+		{[]any{"a||b||c", "||", 2}, false, false, "a||b"},
+		{[]any{"a||b||c", "||", -2}, false, false, "b||c"},
+		{[]any{"a||b||c", "||", 0}, false, false, ""},
+		{[]any{"www.pingcap.com", "pingcap", 1}, false, false, "www."},
+		{[]any{"www.pingcap.com", "pingcap", -1}, false, false, ".com"},
+		{[]any{"www.pingcap.com", "tidb", 1}, false, false, "www.pingcap.com"},
+		{[]any{"www.pingcap.com", "tidb", -1}, false, false, "www.pingcap.com"},
+		{[]any{"abc", "abcd", 1}, false, false, "abc"},
+		{[]any{"abc", "abcd", -1}, false, false, "abc"},
+		{[]any{"abc", "abcd", 0}, false, false, ""},
+		{[]any{"abc", "abc", 1}, false, false, ""},
+		{[]any{"abc", "abc", -1}, false, false, ""},
 		{[]any{nil, ".", 1}, true, false, ""},
 		{[]any{"www.pingcap.com", nil, 1}, true, false, ""},
 		{[]any{"www.pingcap.com", ".", nil}, true, false, ""},
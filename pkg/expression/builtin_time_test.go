@@ -2826,6 +2826,13 @@ func TestConvertTz(t *testing.T) {
 		{"2007-03-11 2:00:00", "US/Eastern", "US/Central", true, "2007-03-11 01:00:00"},
 		{"2007-03-11 3:00:00", "US/Eastern", "US/Central", true, "2007-03-11 01:00:00"},
 
+		{"2004-01-01 12:00:00", "+00:00", "+08:00", true, "2004-01-01 20:00:00"},
+		{"2004-01-01 20:00:00", "+08:00", "+00:00", true, "2004-01-01 12:00:00"},
+		{"2004-01-01 12:00:00", "UTC", "Asia/Shanghai", true, "2004-01-01 20:00:00"},
+		{"2004-01-01 12:00:00", "Asia/Tokyo", "America/New_York", true, "2003-12-31 22:00:00"},
+		{"2004-01-01 12:00:00", "Mars/Olympus_Mons", "UTC", true, ""},
+		{"2004-01-01 12:00:00", "UTC", "Mars/Olympus_Mons", true, ""},
+
 		{"2004-10-00 12:00:00", "GMT", "MET", true, ""},
 		{"2004-00-01 12:00:00", "GMT", "MET", true, ""},
 	}
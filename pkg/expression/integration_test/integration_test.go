@@ -199,6 +199,34 @@ func TestGetLock(t *testing.T) {
 	tk.MustQuery("SELECT release_all_locks()").Check(testkit.Rows("0"))
 }
 
+func TestUUIDShortNotFolded(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t values (1), (2), (3)")
+
+	// uuid_short() is evaluated for every row instead of being folded into a constant.
+	rows := tk.MustQuery("select uuid_short() from t").Rows()
+	require.Len(t, rows, 3)
+	values := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		values[row[0].(string)] = struct{}{}
+	}
+	require.Len(t, values, 3)
+	for _, row := range tk.MustQuery("explain format = 'brief' select uuid_short() from t").Rows() {
+		require.NotRegexp(t, `^\d+->Column`, row[4])
+	}
+
+	// Like the server_id of MySQL, the server ID of the instance is in the top 8 bits, followed by
+	// the startup time in seconds shifted left by 24 bits.
+	serverID := domain.GetDomain(tk.Session()).ServerID()
+	require.NotZero(t, serverID)
+	tk.MustQuery("select uuid_short() >> 56").Check(testkit.Rows(strconv.FormatUint(serverID&0xff, 10)))
+	tk.MustQuery("select (uuid_short() & 0xffffffffffffff) >> 24 between unix_timestamp() - 3600 and unix_timestamp()").Check(testkit.Rows("1"))
+}
+
 func TestInfoBuiltin(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 
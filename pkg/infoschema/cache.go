@@ -34,8 +34,16 @@ type InfoCache struct {
 
 	r    autoid.Requirement
 	Data *Data
+
+	// listeners are called when a newer infoschema becomes the latest one.
+	listeners []SchemaChangeListener
 }
 
+// SchemaChangeListener is notified when a newer infoschema is installed into
+// the InfoCache. oldSchema is the previous latest infoschema, it is nil if the
+// cache was empty.
+type SchemaChangeListener func(oldSchema, newSchema InfoSchema)
+
 type schemaAndTimestamp struct {
 	infoschema InfoSchema
 	timestamp  int64
@@ -82,6 +90,16 @@ func (h *InfoCache) Reset(capacity int) {
 	h.cache = make([]schemaAndTimestamp, 0, capacity)
 }
 
+// SubscribeChange registers a listener, which is called after every Insert that
+// makes a newer infoschema the latest one. It is called synchronously and
+// outside the lock of InfoCache, so it should be cheap and must not block.
+// Concurrent inserts may notify the listeners concurrently.
+func (h *InfoCache) SubscribeChange(listener SchemaChangeListener) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.listeners = append(h.listeners, listener)
+}
+
 // GetLatest gets the newest information schema.
 func (h *InfoCache) GetLatest() InfoSchema {
 	h.mu.RLock()
@@ -190,8 +208,24 @@ func (h *InfoCache) GetBySnapshotTS(snapshotTS uint64) InfoSchema {
 func (h *InfoCache) Insert(is InfoSchema, schemaTS uint64) bool {
 	logutil.BgLogger().Debug("INSERT SCHEMA", zap.Uint64("schema ts", schemaTS), zap.Int64("schema version", is.SchemaMetaVersion()))
 	h.mu.Lock()
-	defer h.mu.Unlock()
+	var oldLatest InfoSchema
+	if len(h.cache) > 0 {
+		oldLatest = h.cache[0].infoschema
+	}
+	cached := h.insertNoLock(is, schemaTS)
+	latestChanged := len(h.cache) > 0 && h.cache[0].infoschema == is && oldLatest != is
+	listeners := h.listeners
+	h.mu.Unlock()
+
+	if latestChanged {
+		for _, listener := range listeners {
+			listener(oldLatest, is)
+		}
+	}
+	return cached
+}
 
+func (h *InfoCache) insertNoLock(is InfoSchema, schemaTS uint64) bool {
 	version := is.SchemaMetaVersion()
 
 	// assume this is the timestamp order as well
@@ -32,6 +32,7 @@ var perfSchemaTables = []string{
 	tableTransHistory,
 	tableTransHistoryLong,
 	tableSessionVariables,
+	tableThreads,
 	tableStagesCurrent,
 	tableStagesHistory,
 	tableStagesHistoryLong,
@@ -565,3 +566,24 @@ const tableSessionAccountConnectAttrs = "CREATE TABLE IF NOT EXISTS " + tableNam
 	"ATTR_NAME varchar(32) COLLATE utf8mb4_bin NOT NULL," +
 	"ATTR_VALUE varchar(1024) COLLATE utf8mb4_bin DEFAULT NULL," +
 	"ORDINAL_POSITION int DEFAULT NULL);"
+
+// tableThreads contains the column name definitions for table threads, same as MySQL.
+const tableThreads = "CREATE TABLE IF NOT EXISTS performance_schema." + tableNameThreads + " (" +
+	"THREAD_ID BIGINT(20) UNSIGNED NOT NULL," +
+	"NAME VARCHAR(128) NOT NULL," +
+	"TYPE VARCHAR(10) NOT NULL," +
+	"PROCESSLIST_ID BIGINT(20) UNSIGNED," +
+	"PROCESSLIST_USER VARCHAR(32)," +
+	"PROCESSLIST_HOST VARCHAR(255)," +
+	"PROCESSLIST_DB VARCHAR(64)," +
+	"PROCESSLIST_COMMAND VARCHAR(16)," +
+	"PROCESSLIST_TIME BIGINT(20)," +
+	"PROCESSLIST_STATE VARCHAR(64)," +
+	"PROCESSLIST_INFO LONGTEXT," +
+	"PARENT_THREAD_ID BIGINT(20) UNSIGNED," +
+	"ROLE VARCHAR(64)," +
+	"INSTRUMENTED VARCHAR(3) NOT NULL," +
+	"HISTORY VARCHAR(3) NOT NULL," +
+	"CONNECTION_TYPE VARCHAR(16)," +
+	"THREAD_OS_ID BIGINT(20) UNSIGNED," +
+	"RESOURCE_GROUP VARCHAR(64));"
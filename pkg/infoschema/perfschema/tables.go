@@ -74,6 +74,7 @@ const (
 	tableNameSessionAccountConnectAttrs      = "session_account_connect_attrs"
 	tableNameSessionConnectAttrs             = "session_connect_attrs"
 	tableNameSessionVariables                = "session_variables"
+	tableNameThreads                         = "threads"
 )
 
 var tableIDMap = map[string]int64{
@@ -110,6 +111,7 @@ var tableIDMap = map[string]int64{
 	tableNameSessionVariables:                autoid.PerformanceSchemaDBID + 31,
 	tableNameSessionConnectAttrs:             autoid.PerformanceSchemaDBID + 32,
 	tableNameSessionAccountConnectAttrs:      autoid.PerformanceSchemaDBID + 33,
+	tableNameThreads:                         autoid.PerformanceSchemaDBID + 34,
 }
 
 // perfSchemaTable stands for the fake table all its data is in the memory.
@@ -259,6 +261,8 @@ func (vt *perfSchemaTable) getRows(ctx context.Context, sctx sessionctx.Context,
 		fullRows, err = infoschema.GetDataFromSessionConnectAttrs(sctx, false)
 	case tableNameSessionAccountConnectAttrs:
 		fullRows, err = infoschema.GetDataFromSessionConnectAttrs(sctx, true)
+	case tableNameThreads:
+		fullRows, err = infoschema.GetDataFromThreads(sctx)
 	}
 	if err != nil {
 		return
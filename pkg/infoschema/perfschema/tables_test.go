@@ -23,14 +23,18 @@ import (
 	"runtime/pprof"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/infoschema/perfschema"
 	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/session"
 	"github.com/pingcap/tidb/pkg/store/mockstore"
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/util"
 	"github.com/stretchr/testify/require"
 	pd "github.com/tikv/pd/client/http"
 	"go.opencensus.io/stats/view"
@@ -200,6 +204,31 @@ func TestSessionConnectAttrs(t *testing.T) {
 	tk.MustQuery("SELECT PROCESSLIST_ID,ATTR_NAME,ATTR_VALUE,ORDINAL_POSITION FROM performance_schema.SESSION_CONNECT_ATTRS").Check(testkit.Rows("123456 _client_name libmysql 0"))
 }
 
+// TestThreads tests the `THREADS` table
+func TestThreads(t *testing.T) {
+	sm := &testkit.MockSessionManager{}
+	sm.PS = []*util.ProcessInfo{
+		{ID: 2, User: "user2", Host: "localhost", DB: "test", Command: mysql.ComQuery, Info: "select 1", Time: time.Now()},
+		{ID: 3, User: "root", Host: "127.0.0.1", Command: mysql.ComSleep, Time: time.Now()},
+		// The auto analyze process, whose ID is returned by MockSessionManager.GetAutoAnalyzeProcID.
+		{ID: 1, Command: mysql.ComQuery, Info: "analyze table test.t", Time: time.Now()},
+	}
+	store := newMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.Session().SetSessionManager(sm)
+	tk.MustQuery("SELECT THREAD_ID,NAME,TYPE,PROCESSLIST_ID,PROCESSLIST_USER,PROCESSLIST_HOST,PROCESSLIST_DB,PROCESSLIST_COMMAND,PROCESSLIST_INFO,INSTRUMENTED FROM performance_schema.THREADS").Check(testkit.Rows(
+		"1 thread/sql/auto_analyze BACKGROUND 1   <nil> Query analyze table test.t YES",
+		"2 thread/sql/one_connection FOREGROUND 2 user2 localhost test Query select 1 YES",
+		"3 thread/sql/one_connection FOREGROUND 3 root 127.0.0.1 <nil> Sleep <nil> YES",
+	))
+
+	// Without the PROCESS privilege, only the threads of the same user are visible.
+	tk.MustExec("create user user2")
+	tk.MustExec("grant select on performance_schema.threads to user2")
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "user2", Hostname: "localhost"}, nil, nil, nil))
+	tk.MustQuery("SELECT THREAD_ID FROM performance_schema.THREADS").Check(testkit.Rows("2"))
+}
+
 func newMockStore(t *testing.T) kv.Storage {
 	store, err := mockstore.NewMockStore()
 	require.NoError(t, err)
@@ -104,12 +104,13 @@ const (
 	// TableEngines is the string constant of infoschema table.
 	TableEngines = "ENGINES"
 	// TableViews is the string constant of infoschema table.
-	TableViews           = "VIEWS"
-	tableRoutines        = "ROUTINES"
-	tableParameters      = "PARAMETERS"
-	tableEvents          = "EVENTS"
-	tableGlobalStatus    = "GLOBAL_STATUS"
-	tableGlobalVariables = "GLOBAL_VARIABLES"
+	TableViews        = "VIEWS"
+	tableRoutines     = "ROUTINES"
+	tableParameters   = "PARAMETERS"
+	tableEvents       = "EVENTS"
+	tableGlobalStatus = "GLOBAL_STATUS"
+	// TableGlobalVariables is the string constant of GLOBAL_VARIABLES.
+	TableGlobalVariables = "GLOBAL_VARIABLES"
 	tableSessionStatus   = "SESSION_STATUS"
 	tableOptimizerTrace  = "OPTIMIZER_TRACE"
 	tableTableSpaces     = "TABLESPACES"
@@ -260,7 +261,7 @@ var tableIDMap = map[string]int64{
 	tableParameters:                         autoid.InformationSchemaDBID + 25,
 	tableEvents:                             autoid.InformationSchemaDBID + 26,
 	tableGlobalStatus:                       autoid.InformationSchemaDBID + 27,
-	tableGlobalVariables:                    autoid.InformationSchemaDBID + 28,
+	TableGlobalVariables:                    autoid.InformationSchemaDBID + 28,
 	tableSessionStatus:                      autoid.InformationSchemaDBID + 29,
 	tableOptimizerTrace:                     autoid.InformationSchemaDBID + 30,
 	tableTableSpaces:                        autoid.InformationSchemaDBID + 31,
@@ -2117,13 +2118,25 @@ func SysVarHiddenForSem(ctx sessionctx.Context, sysVarNameInLower string) bool {
 	return true
 }
 
+// SysVarListedAsSession checks if a given sysvar is listed in the session variables.
+// The noop variables are listed only when tidb_enable_noop_variables is on.
+func SysVarListedAsSession(v *variable.SysVar) bool {
+	return !v.IsNoop || variable.EnableNoopVariables.Load()
+}
+
+// SysVarListedAsGlobal checks if a given sysvar is listed in the global variables,
+// which include the read-only ones of ScopeNone.
+func SysVarListedAsGlobal(v *variable.SysVar) bool {
+	return v.Scope != variable.ScopeSession && SysVarListedAsSession(v)
+}
+
 // GetDataFromSessionVariables return the [name, value] of all session variables
 func GetDataFromSessionVariables(ctx context.Context, sctx sessionctx.Context) ([][]types.Datum, error) {
 	sessionVars := sctx.GetSessionVars()
 	sysVars := variable.GetSysVars()
 	rows := make([][]types.Datum, 0, len(sysVars))
 	for _, v := range sysVars {
-		if SysVarHiddenForSem(sctx, v.Name) {
+		if !SysVarListedAsSession(v) || SysVarHiddenForSem(sctx, v.Name) {
 			continue
 		}
 		var value string
@@ -2137,6 +2150,25 @@ func GetDataFromSessionVariables(ctx context.Context, sctx sessionctx.Context) (
 	return rows, nil
 }
 
+// GetDataFromGlobalVariables return the [name, value] of all global variables
+func GetDataFromGlobalVariables(ctx context.Context, sctx sessionctx.Context) ([][]types.Datum, error) {
+	sessionVars := sctx.GetSessionVars()
+	sysVars := variable.GetSysVars()
+	rows := make([][]types.Datum, 0, len(sysVars))
+	for _, v := range sysVars {
+		if !SysVarListedAsGlobal(v) || SysVarHiddenForSem(sctx, v.Name) {
+			continue
+		}
+		value, err := sessionVars.GetGlobalSystemVar(ctx, v.Name)
+		if err != nil {
+			return nil, err
+		}
+		row := types.MakeDatums(v.Name, value)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
 // GetDataFromSessionConnectAttrs produces the rows for the session_connect_attrs table.
 func GetDataFromSessionConnectAttrs(sctx sessionctx.Context, sameAccount bool) ([][]types.Datum, error) {
 	sm := sctx.GetSessionManager()
@@ -2172,6 +2204,60 @@ func GetDataFromSessionConnectAttrs(sctx sessionctx.Context, sameAccount bool) (
 	return rows, nil
 }
 
+// GetDataFromThreads produces the rows for the threads table. Every connection is
+// a foreground thread, and the auto analyze process is a background thread. The
+// THREAD_ID of a thread is the same as its PROCESSLIST_ID.
+func GetDataFromThreads(sctx sessionctx.Context) ([][]types.Datum, error) {
+	sm := sctx.GetSessionManager()
+	if sm == nil {
+		return nil, nil
+	}
+	loginUser := sctx.GetSessionVars().User
+	checker := privilege.GetPrivilegeManager(sctx)
+	// If you have the PROCESS privilege, you can see all threads.
+	// Otherwise, you can see only your own threads.
+	hasProcessPriv := checker == nil || checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, "", "", "", mysql.ProcessPriv)
+	pl := sm.ShowProcessList()
+	ids := make([]uint64, 0, len(pl))
+	for id, pi := range pl {
+		if !hasProcessPriv && loginUser != nil && pi.User != loginUser.Username {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	// The process list also contains the auto analyze process, which is the only
+	// system process tracked by the SysProcTracker, it is shown as a background thread.
+	autoAnalyzeProcID := sm.GetAutoAnalyzeProcID()
+	rows := make([][]types.Datum, 0, len(ids))
+	for _, id := range ids {
+		pi := pl[id]
+		// id, user, host, db, command, time, state, info
+		show := pi.ToRowForShow(true)
+		name, tp := "thread/sql/one_connection", "FOREGROUND"
+		if id == autoAnalyzeProcID {
+			name, tp = "thread/sql/auto_analyze", "BACKGROUND"
+		}
+		row := types.MakeDatums(
+			pi.ID, // THREAD_ID
+			name,  // NAME
+			tp,    // TYPE
+		)
+		row = append(row, types.MakeDatums(show...)...)
+		row = append(row, types.MakeDatums(
+			nil,                  // PARENT_THREAD_ID
+			nil,                  // ROLE
+			"YES",                // INSTRUMENTED
+			"YES",                // HISTORY
+			nil,                  // CONNECTION_TYPE
+			nil,                  // THREAD_OS_ID
+			pi.ResourceGroupName, // RESOURCE_GROUP
+		)...)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
 var tableNameToColumns = map[string][]columnInfo{
 	TableSchemata:                           schemataCols,
 	TableTables:                             tablesCols,
@@ -2199,7 +2285,7 @@ var tableNameToColumns = map[string][]columnInfo{
 	tableParameters:                         tableParametersCols,
 	tableEvents:                             tableEventsCols,
 	tableGlobalStatus:                       tableGlobalStatusCols,
-	tableGlobalVariables:                    tableGlobalVariablesCols,
+	TableGlobalVariables:                    tableGlobalVariablesCols,
 	tableSessionStatus:                      tableSessionStatusCols,
 	tableOptimizerTrace:                     tableOptimizerTraceCols,
 	tableTableSpaces:                        tableTableSpacesCols,
@@ -291,3 +291,33 @@ func TestCacheWithSchemaTsZero(t *testing.T) {
 	checkFn(85, 100, true)
 	require.Equal(t, 16, ic.Size())
 }
+
+func TestSubscribeChange(t *testing.T) {
+	ic := infoschema.NewCache(nil, 3)
+	type change struct {
+		oldVer, newVer int64
+	}
+	var changes []change
+	ic.SubscribeChange(func(oldSchema, newSchema infoschema.InfoSchema) {
+		oldVer := int64(-1)
+		if oldSchema != nil {
+			oldVer = oldSchema.SchemaMetaVersion()
+		}
+		changes = append(changes, change{oldVer, newSchema.SchemaMetaVersion()})
+	})
+
+	is2 := infoschema.MockInfoSchemaWithSchemaVer(nil, 2)
+	require.True(t, ic.Insert(is2, 2))
+	is5 := infoschema.MockInfoSchemaWithSchemaVer(nil, 5)
+	require.True(t, ic.Insert(is5, 5))
+	require.Equal(t, []change{{-1, 2}, {2, 5}}, changes)
+
+	// inserting a cached or an older version doesn't change the latest one
+	require.True(t, ic.Insert(is5, 5))
+	require.True(t, ic.Insert(infoschema.MockInfoSchemaWithSchemaVer(nil, 3), 3))
+	require.Equal(t, []change{{-1, 2}, {2, 5}}, changes)
+
+	is6 := infoschema.MockInfoSchemaWithSchemaVer(nil, 6)
+	require.True(t, ic.Insert(is6, 6))
+	require.Equal(t, []change{{-1, 2}, {2, 5}, {5, 6}}, changes)
+}
@@ -227,6 +227,7 @@ func RegisterMetrics() {
 	prometheus.MustRegister(PDAPIRequestCounter)
 	prometheus.MustRegister(CPUProfileCounter)
 	prometheus.MustRegister(ReadFromTableCacheCounter)
+	prometheus.MustRegister(QueryResultCacheCounter)
 	prometheus.MustRegister(LoadTableCacheDurationHistogram)
 	prometheus.MustRegister(NonTransactionalDMLCount)
 	prometheus.MustRegister(PessimisticDMLDurationByAttempt)
@@ -299,6 +300,7 @@ func ToggleSimplifiedMode(simplified bool) {
 		SmallTxnWriteDuration,
 		InfoCacheCounters,
 		ReadFromTableCacheCounter,
+		QueryResultCacheCounter,
 		TiFlashQueryTotalCounter,
 		TiFlashFailedMPPStoreState,
 		CampaignOwnerCounter,
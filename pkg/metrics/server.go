@@ -50,6 +50,7 @@ var (
 	PlanCacheInstanceMemoryUsage    *prometheus.GaugeVec
 	PlanCacheInstancePlanNumCounter *prometheus.GaugeVec
 	ReadFromTableCacheCounter       prometheus.Counter
+	QueryResultCacheCounter         *prometheus.CounterVec
 	HandShakeErrorCounter           prometheus.Counter
 	GetTokenDurationHistogram       prometheus.Histogram
 	NumOfMultiQueryHistogram        prometheus.Histogram
@@ -203,6 +204,14 @@ func InitServerMetrics() {
 		},
 	)
 
+	QueryResultCacheCounter = NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tidb",
+			Subsystem: "server",
+			Name:      "query_result_cache_total",
+			Help:      "Counter of query result cache lookups.",
+		}, []string{LblType})
+
 	HandShakeErrorCounter = NewCounter(
 		prometheus.CounterOpts{
 			Namespace: "tidb",
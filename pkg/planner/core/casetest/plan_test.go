@@ -15,11 +15,15 @@
 package casetest
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 	"testing"
 
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/planner"
 	"github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/testkit/testdata"
@@ -307,3 +311,42 @@ func TestJSONPlanInExplain(t *testing.T) {
 		}
 	}
 }
+
+func TestOptimizeWithAlternatives(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, c int, key ia(a))")
+
+	sctx := tk.Session()
+	is := sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+	stmt, err := parser.New().ParseOneStmt("select * from t where a > 10", "", "")
+	require.NoError(t, err)
+	require.NoError(t, core.Preprocess(context.Background(), sctx, stmt, core.WithPreprocessorReturn(&core.PreprocessorReturn{InfoSchema: is})))
+	p, candidates, err := planner.OptimizeWithAlternatives(context.Background(), sctx, stmt, is)
+	require.NoError(t, err)
+	require.NotNil(t, p)
+
+	// Every candidate is costed, and the cheapest access path is selected.
+	costs := make(map[string]float64)
+	for _, c := range candidates {
+		require.NotNil(t, c.Plan)
+		require.Greater(t, c.Cost, 0.0)
+		costs[c.Plan.TP()] = c.Cost
+	}
+	require.Contains(t, costs, plancodec.TypeTableReader)
+	require.Contains(t, costs, plancodec.TypeIndexLookUp)
+	require.Greater(t, costs[plancodec.TypeIndexLookUp], costs[plancodec.TypeTableReader])
+	require.Equal(t, plancodec.TypeTableReader, p.TP())
+
+	// Fast plans skip the physical optimization, so there is no alternative.
+	tk.MustExec("create table t2(a int primary key, b int)")
+	is = sctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+	stmt, err = parser.New().ParseOneStmt("select * from t2 where a = 1", "", "")
+	require.NoError(t, err)
+	require.NoError(t, core.Preprocess(context.Background(), sctx, stmt, core.WithPreprocessorReturn(&core.PreprocessorReturn{InfoSchema: is})))
+	p, candidates, err = planner.OptimizeWithAlternatives(context.Background(), sctx, stmt, is)
+	require.NoError(t, err)
+	require.NotNil(t, p)
+	require.Empty(t, candidates)
+}
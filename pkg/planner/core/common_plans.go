@@ -828,7 +828,7 @@ func (e *Explain) prepareSchema() error {
 		fieldNames = []string{"hint"}
 	case format == types.ExplainFormatBinary:
 		fieldNames = []string{"binary plan"}
-	case format == types.ExplainFormatTiDBJSON:
+	case format == types.ExplainFormatTiDBJSON || format == types.ExplainFormatJSON:
 		fieldNames = []string{"TiDB_JSON"}
 	default:
 		return errors.Errorf("explain format '%s' is not supported now", e.Format)
@@ -929,7 +929,7 @@ func (e *Explain) RenderResult() error {
 		flat := FlattenPhysicalPlan(e.TargetPlan, false)
 		str := BinaryPlanStrFromFlatPlan(e.SCtx(), flat)
 		e.Rows = append(e.Rows, []string{str})
-	case types.ExplainFormatTiDBJSON:
+	case types.ExplainFormatTiDBJSON, types.ExplainFormatJSON:
 		flat := FlattenPhysicalPlan(e.TargetPlan, true)
 		encodes := e.explainFlatPlanInJSONFormat(flat)
 		if e.Analyze && len(encodes) > 0 &&
@@ -1110,7 +1110,7 @@ func (e *Explain) prepareOperatorInfoForJSONFormat(p Plan, taskType, id string,
 		return nil
 	}
 
-	estRows, _, _, accessObject, operatorInfo := e.getOperatorInfo(p, id)
+	estRows, estCost, _, accessObject, operatorInfo := e.getOperatorInfo(p, id)
 	jsonRow := &ExplainInfoForEncode{
 		ID:           explainID,
 		EstRows:      estRows,
@@ -1119,6 +1119,10 @@ func (e *Explain) prepareOperatorInfoForJSONFormat(p Plan, taskType, id string,
 		OperatorInfo: operatorInfo,
 		SubOperators: make([]*ExplainInfoForEncode, 0),
 	}
+	// `explain format = 'json'` is the same as `tidb_json`, but also shows the cost of each operator.
+	if strings.ToLower(e.Format) == types.ExplainFormatJSON {
+		jsonRow.EstCost = estCost
+	}
 
 	if e.Analyze || e.RuntimeStatsColl != nil {
 		jsonRow.ActRows, jsonRow.ExecuteInfo, jsonRow.MemoryInfo, jsonRow.DiskInfo = getRuntimeInfoStr(e.SCtx(), p, e.RuntimeStatsColl)
@@ -96,6 +96,15 @@ func TestBetween(t *testing.T) {
 		{exprStr: "'2001-04-10 12:34:56' between cast('2001-01-01 01:01:01' as datetime) and '01-05-01'", resultStr: "1"},
 		{exprStr: "20010410123456 between cast('2001-01-01 01:01:01' as datetime) and 010501", resultStr: "0"},
 		{exprStr: "20010410123456 between cast('2001-01-01 01:01:01' as datetime) and 20010501123456", resultStr: "1"},
+		// NOT BETWEEN follows three-valued logic: a NULL operand makes the
+		// result NULL unless the other bound alone already decides it.
+		{exprStr: "null not between 1 and 3", resultStr: "<nil>"},
+		{exprStr: "null not between null and null", resultStr: "<nil>"},
+		{exprStr: "2 not between null and 3", resultStr: "<nil>"},
+		{exprStr: "5 not between null and 3", resultStr: "1"},
+		{exprStr: "2 not between 1 and null", resultStr: "<nil>"},
+		{exprStr: "0 not between 1 and null", resultStr: "1"},
+		{exprStr: "2 not between null and null", resultStr: "<nil>"},
 	}
 	runTests(t, tests)
 }
@@ -243,6 +252,30 @@ func TestPatternIn(t *testing.T) {
 	runTests(t, tests)
 }
 
+func TestSubstring(t *testing.T) {
+	tests := []testCase{
+		// The FROM/FOR keyword forms evaluate the same as the comma forms.
+		{exprStr: "substring('Quadratically', 5)", resultStr: "ratically"},
+		{exprStr: "substring('Quadratically' from 5)", resultStr: "ratically"},
+		{exprStr: "substring('Quadratically', 5, 6)", resultStr: "ratica"},
+		{exprStr: "substring('Quadratically' from 5 for 6)", resultStr: "ratica"},
+		{exprStr: "substring('Sakila', -3)", resultStr: "ila"},
+		{exprStr: "substring('Sakila' from -3)", resultStr: "ila"},
+		{exprStr: "substring('Sakila', -5, 3)", resultStr: "aki"},
+		{exprStr: "substring('Sakila' from -4 for 2)", resultStr: "ki"},
+		{exprStr: "substr('Sakila' from 2 for 3)", resultStr: "aki"},
+		{exprStr: "substring('Sakila' from 0 for 3)", resultStr: ""},
+		{exprStr: "substring('Sakila' from 2 for 0)", resultStr: ""},
+		{exprStr: "substring('Sakila' from 2 for -1)", resultStr: ""},
+		{exprStr: "substring('Sakila', 2, -1)", resultStr: ""},
+		{exprStr: "substring('Sakila' from 10 for 2)", resultStr: ""},
+		{exprStr: "substring(null from 2 for 3)", resultStr: "<nil>"},
+		{exprStr: "substring('Sakila' from null for 3)", resultStr: "<nil>"},
+		{exprStr: "substring('Sakila' from 2 for null)", resultStr: "<nil>"},
+	}
+	runTests(t, tests)
+}
+
 func TestIsNull(t *testing.T) {
 	tests := []testCase{
 		{
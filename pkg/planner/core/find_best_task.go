@@ -280,6 +280,7 @@ func (p *baseLogicalPlan) enumeratePhysicalPlans4Task(
 			break
 		}
 		opt.appendCandidate(p, curTask.plan(), prop)
+		opt.appendAlternative(curTask)
 		// Get the most efficient one.
 		if curIsBetter, err := compareTaskCost(curTask, bestTask, opt); err != nil {
 			return nil, 0, err
@@ -489,8 +490,22 @@ func getTaskPlanCost(t task, op *physicalOptimizeOp) (float64, bool, error) {
 type physicalOptimizeOp struct {
 	// tracer is goring to track optimize steps during physical optimizing
 	tracer *tracing.PhysicalOptimizeTracer
+	// alternatives collects the candidate plans costed during physical optimizing if it is not nil.
+	alternatives *[]*PlanAlternative
 }
 
+// PlanAlternative is a candidate physical plan costed by the optimizer.
+type PlanAlternative struct {
+	Plan PhysicalPlan
+	Cost float64
+}
+
+type planAlternativesCtxKeyType struct{}
+
+// PlanAlternativesCtxKey is the context key to collect the candidate plans costed
+// during physical optimizing. The value should be a *[]*PlanAlternative.
+var PlanAlternativesCtxKey = planAlternativesCtxKeyType{}
+
 func defaultPhysicalOptimizeOption() *physicalOptimizeOp {
 	return &physicalOptimizeOp{}
 }
@@ -500,6 +515,22 @@ func (op *physicalOptimizeOp) withEnableOptimizeTracer(tracer *tracing.PhysicalO
 	return op
 }
 
+func (op *physicalOptimizeOp) withPlanAlternatives(alternatives *[]*PlanAlternative) *physicalOptimizeOp {
+	op.alternatives = alternatives
+	return op
+}
+
+func (op *physicalOptimizeOp) appendAlternative(t task) {
+	if op == nil || op.alternatives == nil || t == nil || t.invalid() {
+		return
+	}
+	cost, _, err := getTaskPlanCost(t, op)
+	if err != nil {
+		return
+	}
+	*op.alternatives = append(*op.alternatives, &PlanAlternative{Plan: t.plan(), Cost: cost})
+}
+
 func (op *physicalOptimizeOp) appendCandidate(lp LogicalPlan, pp PhysicalPlan, prop *property.PhysicalProperty) {
 	if op == nil || op.tracer == nil || pp == nil {
 		return
@@ -576,7 +607,7 @@ func (p *baseLogicalPlan) findBestTask(prop *property.PhysicalProperty, planCoun
 	var hintWorksWithProp bool
 	// Maybe the plan can satisfy the required property,
 	// so we try to get the task without the enforced sort first.
-	plansFitsProp, hintWorksWithProp, err = p.self.exhaustPhysicalPlans(newProp)
+	plansFitsProp, hintWorksWithProp, err = exhaustPhysicalPlansWithExtensions(p.self, newProp)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -596,7 +627,7 @@ func (p *baseLogicalPlan) findBestTask(prop *property.PhysicalProperty, planCoun
 		newProp.MPPPartitionCols = nil
 		newProp.MPPPartitionTp = property.AnyType
 		var hintCanWork bool
-		plansNeedEnforce, hintCanWork, err = p.self.exhaustPhysicalPlans(newProp)
+		plansNeedEnforce, hintCanWork, err = exhaustPhysicalPlansWithExtensions(p.self, newProp)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -634,6 +665,7 @@ func (p *baseLogicalPlan) findBestTask(prop *property.PhysicalProperty, planCoun
 		goto END
 	}
 	opt.appendCandidate(p, curTask.plan(), prop)
+	opt.appendAlternative(curTask)
 	if curIsBetter, err := compareTaskCost(curTask, bestTask, opt); err != nil {
 		return nil, 0, err
 	} else if curIsBetter {
@@ -2636,6 +2668,7 @@ func appendCandidate(lp LogicalPlan, task task, prop *property.PhysicalProperty,
 		return
 	}
 	opt.appendCandidate(lp, task.plan(), prop)
+	opt.appendAlternative(task)
 }
 
 // PushDownNot here can convert condition 'not (a != 1)' to 'a = 1'. When we build range from conds, the condition like
@@ -444,3 +444,45 @@ type assertTraceStep struct {
 	assertReason string
 	assertAction string
 }
+
+// tracedRuleExtension records a step, so that it is kept in the logical optimize trace.
+type tracedRuleExtension struct{}
+
+func (tracedRuleExtension) name() string {
+	return "traced_rule_extension"
+}
+
+func (tracedRuleExtension) optimize(_ context.Context, p LogicalPlan, opt *logicalOptimizeOp) (LogicalPlan, bool, error) {
+	opt.appendStepToCurrent(p.ID(), p.TP(), func() string { return "" }, func() string { return "" })
+	return p, false, nil
+}
+
+func TestRuleExtensionTraceIndex(t *testing.T) {
+	defer ResetRuleExtensionsForTest()
+	logicalRuleExtensions = append(logicalRuleExtensions, tracedRuleExtension{})
+	s := createPlannerSuite()
+	defer s.Close()
+	stmt, err := s.p.ParseOneStmt("select a from t where a > 1", "", "")
+	require.NoError(t, err)
+	err = Preprocess(context.Background(), s.sctx, stmt, WithPreprocessorReturn(&PreprocessorReturn{InfoSchema: s.is}))
+	require.NoError(t, err)
+	sctx := MockContext()
+	sctx.GetSessionVars().StmtCtx.EnableOptimizeTrace = true
+	builder, _ := NewPlanBuilder().Init(sctx, s.is, hint.NewQBHintHandler(nil))
+	domain.GetDomain(sctx).MockInfoCacheAndLoadInfoSchema(s.is)
+	ctx := context.TODO()
+	p, err := builder.Build(ctx, stmt)
+	require.NoError(t, err)
+	_, err = logicalOptimize(ctx, flagPredicatePushDown|flagPrunColumns, p.(LogicalPlan))
+	require.NoError(t, err)
+	trace := sctx.GetSessionVars().StmtCtx.OptimizeTracer.Logical
+	require.NotNil(t, trace)
+	// The index of the rule extension does not collide with the ones of the builtin rules.
+	indexes := make(map[int]string, len(trace.Steps))
+	for _, step := range trace.Steps {
+		require.NotContains(t, indexes, step.Index, step.RuleName)
+		indexes[step.Index] = step.RuleName
+	}
+	require.Equal(t, "traced_rule_extension", indexes[len(optRuleList)])
+	domain.GetDomain(sctx).StatsHandle().Close()
+}
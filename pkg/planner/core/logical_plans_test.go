@@ -911,6 +911,18 @@ func TestValidate(t *testing.T) {
 			sql: "insert into t set a = 1, b = values(a) + 1",
 			err: nil,
 		},
+		{
+			sql: "insert into t (a, b) select a, b from t",
+			err: nil,
+		},
+		{
+			sql: "insert into t (a, b) select a from t",
+			err: plannererrors.ErrWrongValueCountOnRow,
+		},
+		{
+			sql: "insert into t (a) select a, b from t",
+			err: plannererrors.ErrWrongValueCountOnRow,
+		},
 		{
 			sql: "select a, b, c from t order by 0",
 			err: plannererrors.ErrUnknownColumn,
@@ -1874,7 +1886,7 @@ func (s *plannerSuiteWithOptimizeVars) optimize(ctx context.Context, sql string)
 	if err != nil {
 		return nil, nil, err
 	}
-	p, _, err = physicalOptimize(p.(LogicalPlan), &PlanCounterDisabled)
+	p, _, err = physicalOptimize(ctx, p.(LogicalPlan), &PlanCounterDisabled)
 	return p.(PhysicalPlan), stmt, err
 }
 
@@ -330,7 +330,7 @@ func doOptimize(
 	if planCounter == 0 {
 		planCounter = -1
 	}
-	physical, cost, err := physicalOptimize(logic, &planCounter)
+	physical, cost, err := physicalOptimize(ctx, logic, &planCounter)
 	if err != nil {
 		return nil, nil, 0, err
 	}
@@ -1198,6 +1198,19 @@ func logicalOptimize(ctx context.Context, flag uint64, logic LogicalPlan) (Logic
 		}
 	}
 
+	// Apply the rules registered by RegisterLogicalRuleExtension, their indexes in
+	// the trace follow the builtin ones.
+	for i, rule := range logicalRuleExtensions {
+		if isLogicalRuleDisabled(rule) {
+			continue
+		}
+		opt.appendBeforeRuleOptimize(len(optRuleList)+i, rule.name(), logic)
+		logic, _, err = rule.optimize(ctx, logic, opt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	opt.recordFinalLogicalPlan(logic)
 	return logic, err
 }
@@ -1207,7 +1220,7 @@ func isLogicalRuleDisabled(r logicalOptRule) bool {
 	return disabled
 }
 
-func physicalOptimize(logic LogicalPlan, planCounter *PlanCounterTp) (plan PhysicalPlan, cost float64, err error) {
+func physicalOptimize(ctx context.Context, logic LogicalPlan, planCounter *PlanCounterTp) (plan PhysicalPlan, cost float64, err error) {
 	if logic.SCtx().GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
 		debugtrace.EnterContextCommon(logic.SCtx())
 		defer debugtrace.LeaveContextCommon(logic.SCtx())
@@ -1224,6 +1237,9 @@ func physicalOptimize(logic LogicalPlan, planCounter *PlanCounterTp) (plan Physi
 	}
 
 	opt := defaultPhysicalOptimizeOption()
+	if alternatives, ok := ctx.Value(PlanAlternativesCtxKey).(*[]*PlanAlternative); ok {
+		opt = opt.withPlanAlternatives(alternatives)
+	}
 	stmtCtx := logic.SCtx().GetSessionVars().StmtCtx
 	if stmtCtx.EnableOptimizeTrace {
 		tracer := &tracing.PhysicalOptimizeTracer{
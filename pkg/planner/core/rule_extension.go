@@ -0,0 +1,85 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/pkg/planner/property"
+)
+
+// LogicalRuleExtension is a logical rewrite rule that is not built in the planner,
+// e.g. a custom push down of a downstream fork. It is registered by RegisterLogicalRuleExtension.
+type LogicalRuleExtension interface {
+	// Name returns the name of the rule. Like the builtin rules, the rule is skipped
+	// when its name is in mysql.opt_rule_blacklist.
+	Name() string
+	// Optimize rewrites the logical plan. The returned bool indicates whether the plan is changed.
+	Optimize(ctx context.Context, p LogicalPlan) (LogicalPlan, bool, error)
+}
+
+// PhysicalAlternativesProvider returns extra physical plans for the logical plan p under
+// the required property prop, which must not be modified. They are costed together with
+// the builtin alternatives, and the cheapest one is chosen. It is not called for DataSource,
+// whose access paths are enumerated by the planner itself.
+type PhysicalAlternativesProvider func(p LogicalPlan, prop *property.PhysicalProperty) ([]PhysicalPlan, error)
+
+var (
+	logicalRuleExtensions         []logicalOptRule
+	physicalAlternativesProviders []PhysicalAlternativesProvider
+)
+
+// RegisterLogicalRuleExtension registers a logical rewrite rule, which runs after all the
+// builtin logical rules, in the order of registration.
+// It is not thread safe and should be called before any statement is optimized, e.g. in init.
+func RegisterLogicalRuleExtension(rule LogicalRuleExtension) {
+	logicalRuleExtensions = append(logicalRuleExtensions, &logicalRuleExtension{rule})
+}
+
+// RegisterPhysicalAlternativesProvider registers a provider of physical alternatives.
+// It is not thread safe and should be called before any statement is optimized, e.g. in init.
+func RegisterPhysicalAlternativesProvider(provider PhysicalAlternativesProvider) {
+	physicalAlternativesProviders = append(physicalAlternativesProviders, provider)
+}
+
+// logicalRuleExtension adapts a LogicalRuleExtension to logicalOptRule.
+type logicalRuleExtension struct {
+	rule LogicalRuleExtension
+}
+
+func (r *logicalRuleExtension) optimize(ctx context.Context, p LogicalPlan, _ *logicalOptimizeOp) (LogicalPlan, bool, error) {
+	return r.rule.Optimize(ctx, p)
+}
+
+func (r *logicalRuleExtension) name() string {
+	return r.rule.Name()
+}
+
+// exhaustPhysicalPlansWithExtensions returns the builtin physical alternatives of p
+// followed by the ones of the registered providers.
+func exhaustPhysicalPlansWithExtensions(p LogicalPlan, prop *property.PhysicalProperty) ([]PhysicalPlan, bool, error) {
+	plans, hintCanWork, err := p.exhaustPhysicalPlans(prop)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, provider := range physicalAlternativesProviders {
+		extra, err := provider(p, prop)
+		if err != nil {
+			return nil, false, err
+		}
+		plans = append(plans, extra...)
+	}
+	return plans, hintCanWork, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/planner/property"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/util/plancodec"
+	"github.com/stretchr/testify/require"
+)
+
+type countingRule struct {
+	calls int
+}
+
+func (*countingRule) Name() string {
+	return "counting_rule"
+}
+
+func (r *countingRule) Optimize(_ context.Context, p core.LogicalPlan) (core.LogicalPlan, bool, error) {
+	r.calls++
+	return p, false, nil
+}
+
+func TestRuleExtension(t *testing.T) {
+	defer core.ResetRuleExtensionsForTest()
+	rule := &countingRule{}
+	core.RegisterLogicalRuleExtension(rule)
+	var provided []string
+	core.RegisterPhysicalAlternativesProvider(func(p core.LogicalPlan, _ *property.PhysicalProperty) ([]core.PhysicalPlan, error) {
+		provided = append(provided, p.TP())
+		return nil, nil
+	})
+
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int)")
+	rule.calls, provided = 0, nil
+	tk.MustQuery("select a, count(*) from t group by a")
+	require.Equal(t, 1, rule.calls)
+	require.Contains(t, provided, plancodec.TypeAgg)
+
+	// The rule can be disabled by the blacklist like the builtin ones.
+	tk.MustExec("insert into mysql.opt_rule_blacklist values('counting_rule')")
+	tk.MustExec("admin reload opt_rule_blacklist")
+	defer func() {
+		tk.MustExec("delete from mysql.opt_rule_blacklist where name = 'counting_rule'")
+		tk.MustExec("admin reload opt_rule_blacklist")
+	}()
+	rule.calls = 0
+	tk.MustQuery("select a, count(*) from t group by a")
+	require.Equal(t, 0, rule.calls)
+}
@@ -369,6 +369,22 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 	return bestPlan, names, nil
 }
 
+// OptimizeWithAlternatives does optimization like Optimize, and also returns the
+// candidate physical plans costed by the optimizer while choosing the best plan.
+// It is used by query-tuning tools. The candidates are the tasks of every logical
+// operator, including the ones of subplans and subqueries, rather than only the
+// alternatives of the whole plan. No candidates are returned for plans which skip
+// the physical optimization, like PointGet or a plan from the plan cache.
+func OptimizeWithAlternatives(ctx context.Context, sctx sessionctx.Context, node ast.Node, is infoschema.InfoSchema) (core.Plan, []*core.PlanAlternative, error) {
+	var alternatives []*core.PlanAlternative
+	ctx = context.WithValue(ctx, core.PlanAlternativesCtxKey, &alternatives)
+	p, _, err := Optimize(ctx, sctx, node, is)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p, alternatives, nil
+}
+
 // OptimizeForForeignKeyCascade does optimization and creates a Plan for foreign key cascade.
 // Compare to Optimize, OptimizeForForeignKeyCascade only build plan by StmtNode,
 // doesn't consider plan cache and plan binding, also doesn't do privilege check.
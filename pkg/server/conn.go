@@ -190,6 +190,9 @@ type clientConn struct {
 	rsEncoder     *column.ResultEncoder // rsEncoder is used to encode the string result to different charsets
 	inputDecoder  *util2.InputDecoder   // inputDecoder is used to decode the different charsets of incoming strings to utf-8
 	socketCredUID uint32                // UID from the other end of the Unix Socket
+	// userConnAccount is the account this connection is counted for by max_user_connections,
+	// it's protected by server.rwlock.
+	userConnAccount string
 	// mu is used for cancelling the execution of current transaction.
 	mu struct {
 		sync.RWMutex
@@ -362,6 +365,7 @@ func (cc *clientConn) handshake(ctx context.Context) error {
 func (cc *clientConn) Close() error {
 	cc.server.rwlock.Lock()
 	delete(cc.server.clients, cc.connectionID)
+	cc.server.releaseUserConnectionWithoutLock(cc)
 	resourceGroupName, count := "", 0
 	if ctx := cc.getCtx(); ctx != nil {
 		resourceGroupName = ctx.GetSessionVars().ResourceGroupName
@@ -406,6 +410,7 @@ func closeConn(cc *clientConn, resourceGroupName string, count int) error {
 
 func (cc *clientConn) closeWithoutLock() error {
 	delete(cc.server.clients, cc.connectionID)
+	cc.server.releaseUserConnectionWithoutLock(cc)
 	name := cc.getCtx().GetSessionVars().ResourceGroupName
 	count := cc.server.ConnNumByResourceGroup[name]
 	if count <= 1 {
@@ -517,6 +522,20 @@ func (cc *clientConn) getSessionVarsWaitTimeout(ctx context.Context) uint64 {
 	return waitTimeout
 }
 
+// initInteractiveWaitTimeout sets the session wait_timeout from interactive_timeout
+// when the client sets CLIENT_INTERACTIVE in the handshake, as MySQL does.
+func (cc *clientConn) initInteractiveWaitTimeout() error {
+	if cc.capability&mysql.ClientInteractive == 0 {
+		return nil
+	}
+	sessVars := cc.ctx.GetSessionVars()
+	interactiveTimeout, err := sessVars.GetSessionOrGlobalSystemVar(context.Background(), variable.InteractiveTimeout)
+	if err != nil {
+		return err
+	}
+	return sessVars.SetSystemVar(variable.WaitTimeout, interactiveTimeout)
+}
+
 func (cc *clientConn) readOptionalSSLRequestAndHandshakeResponse(ctx context.Context) error {
 	// Read a packet. It may be a SSLRequest or HandshakeResponse.
 	data, err := cc.readPacket()
@@ -800,6 +819,12 @@ func (cc *clientConn) openSessionAndDoAuth(authData []byte, authPlugin string, z
 	if err = cc.ctx.Auth(userIdentity, authData, cc.salt, cc); err != nil {
 		return err
 	}
+	if err = cc.server.reserveUserConnection(cc); err != nil {
+		return err
+	}
+	if err = cc.initInteractiveWaitTimeout(); err != nil {
+		return err
+	}
 	cc.ctx.SetPort(port)
 	cc.ctx.SetCompressionLevel(zstdLevel)
 	if cc.dbname != "" {
@@ -1359,7 +1384,9 @@ func (cc *clientConn) dispatch(ctx context.Context, data []byte) error {
 		return cc.writeOK(ctx)
 	case mysql.ComStatistics:
 		return cc.writeStats(ctx)
-	// ComProcessInfo, ComConnect, ComProcessKill, ComDebug
+	// ComProcessInfo, ComConnect, ComDebug
+	case mysql.ComProcessKill:
+		return cc.handleProcessKill(ctx, data)
 	case mysql.ComPing:
 		return cc.writeOK(ctx)
 	case mysql.ComChangeUser:
@@ -2502,6 +2529,9 @@ func (cc *clientConn) handleChangeUser(ctx context.Context, data []byte) error {
 	if err := cc.ctx.Close(); err != nil {
 		logutil.Logger(ctx).Debug("close old context failed", zap.Error(err))
 	}
+	// The connection is no longer counted for the previous account by max_user_connections,
+	// it's counted for the new account after the authentication succeeds.
+	cc.server.releaseUserConnection(cc)
 	// session was closed by `ctx.Close` and should `openSession` explicitly to renew session.
 	// `openSession` won't run again in `openSessionAndDoAuth` because ctx is not nil.
 	err := cc.openSession()
@@ -2593,6 +2623,16 @@ func (cc *clientConn) handleRefresh(ctx context.Context, subCommand byte) error
 	return cc.writeOK(ctx)
 }
 
+// handleProcessKill handles COM_PROCESS_KILL, which is deprecated by the KILL
+// statement but still sent by some clients, by redirecting it to SQL.
+func (cc *clientConn) handleProcessKill(ctx context.Context, data []byte) error {
+	if len(data) < 4 {
+		return mysql.ErrMalformPacket
+	}
+	connID := binary.LittleEndian.Uint32(data[:4])
+	return cc.handleQuery(ctx, "KILL "+strconv.FormatUint(uint64(connID), 10))
+}
+
 var _ fmt.Stringer = getLastStmtInConn{}
 
 type getLastStmtInConn struct {
@@ -37,6 +37,7 @@ import (
 	"github.com/pingcap/tidb/pkg/extension"
 	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
+	servererr "github.com/pingcap/tidb/pkg/server/err"
 	"github.com/pingcap/tidb/pkg/server/internal"
 	"github.com/pingcap/tidb/pkg/server/internal/handshake"
 	"github.com/pingcap/tidb/pkg/server/internal/parse"
@@ -436,6 +437,12 @@ func TestDispatch(t *testing.T) {
 			err: nil,
 			out: []byte{0x3, 0x0, 0x0, 0x12, 0x0, 0x0, 0x0},
 		},
+		{
+			com: mysql.ComProcessKill,
+			in:  []byte{0x64},
+			err: mysql.ErrMalformPacket,
+			out: nil,
+		},
 	}
 
 	testDispatch(t, inputs, 0)
@@ -657,6 +664,24 @@ func TestGetSessionVarsWaitTimeout(t *testing.T) {
 	require.Equal(t, uint64(variable.DefWaitTimeout), cc.getSessionVarsWaitTimeout(context.Background()))
 }
 
+func TestInteractiveWaitTimeout(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	srv := CreateMockServer(t, store)
+	defer srv.Close()
+
+	cc := CreateMockConn(t, srv).(*mockConn)
+	defer cc.Close()
+	ctx := context.Background()
+	require.NoError(t, cc.HandleQuery(ctx, "set @@session.interactive_timeout = 100"))
+
+	require.NoError(t, cc.initInteractiveWaitTimeout())
+	require.Equal(t, uint64(variable.DefWaitTimeout), cc.getSessionVarsWaitTimeout(ctx))
+
+	cc.capability |= mysql.ClientInteractive
+	require.NoError(t, cc.initInteractiveWaitTimeout())
+	require.Equal(t, uint64(100), cc.getSessionVarsWaitTimeout(ctx))
+}
+
 func mapIdentical(m1, m2 map[string]string) bool {
 	return mapBelong(m1, m2) && mapBelong(m2, m1)
 }
@@ -788,6 +813,116 @@ func TestShutDown(t *testing.T) {
 	require.Less(t, time.Since(begin), waitTime)
 }
 
+func TestProcessKill(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	srv := CreateMockServer(t, store)
+	defer srv.Close()
+
+	originCfg := config.GetGlobalConfig()
+	newCfg := *originCfg
+	newCfg.EnableGlobalKill = false
+	newCfg.CompatibleKillQuery = true
+	config.StoreGlobalConfig(&newCfg)
+	defer config.StoreGlobalConfig(originCfg)
+
+	killer := CreateMockConn(t, srv)
+	defer killer.Close()
+	victim := CreateMockConn(t, srv).(*mockConn)
+	defer victim.Close()
+	require.NoError(t, victim.HandleQuery(context.Background(), "select 1"))
+	// COM_PROCESS_KILL only carries a 4 bytes connection ID.
+	srv.rwlock.Lock()
+	delete(srv.clients, victim.connectionID)
+	victim.connectionID = 100
+	srv.clients[victim.connectionID] = victim.clientConn
+	srv.rwlock.Unlock()
+
+	require.NoError(t, killer.Dispatch(context.Background(), []byte{mysql.ComProcessKill, 0x64, 0x0, 0x0, 0x0}))
+	require.Equal(t, int32(connStatusWaitShutdown), victim.getStatus())
+}
+
+func TestMaxUserConnections(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	srv := CreateMockServer(t, store)
+	defer srv.Close()
+
+	cc1 := CreateMockConn(t, srv)
+	defer cc1.Close()
+	ctx := context.Background()
+	require.NoError(t, cc1.HandleQuery(ctx, "create user max_conn_user"))
+	require.Error(t, cc1.HandleQuery(ctx, "set @@session.max_user_connections = 1"))
+	require.NoError(t, cc1.HandleQuery(ctx, "set @@global.max_user_connections = 2"))
+	defer func() {
+		require.NoError(t, cc1.HandleQuery(ctx, "set @@global.max_user_connections = default"))
+		require.Equal(t, uint32(0), variable.MaxUserConnectionsValue.Load())
+	}()
+	require.Equal(t, uint32(2), variable.MaxUserConnectionsValue.Load())
+
+	login := func() (*mockConn, error) {
+		cc := CreateMockConn(t, srv).(*mockConn)
+		cc.user = "max_conn_user"
+		cc.peerHost = "127.0.0.1"
+		return cc, cc.openSessionAndDoAuth([]byte{}, mysql.AuthNativePassword, 0)
+	}
+	cc2, err := login()
+	require.NoError(t, err)
+	defer cc2.Close()
+	cc3, err := login()
+	require.NoError(t, err)
+	defer cc3.Close()
+	cc4, err := login()
+	defer cc4.Close()
+	require.ErrorIs(t, err, servererr.ErrTooManyUserConnections)
+	// Authenticating again as the same account does not count the connection twice.
+	require.NoError(t, cc3.openSessionAndDoAuth([]byte{}, mysql.AuthNativePassword, 0))
+
+	stats, err := srv.Stats(nil)
+	require.NoError(t, err)
+	require.Equal(t, 4, stats["Threads_connected"])
+
+	// A closed connection is not counted.
+	cc2.Close()
+	cc5, err := login()
+	require.NoError(t, err)
+	defer cc5.Close()
+	cc3.Close()
+
+	// Concurrent logins can not exceed the limit.
+	var wg sync.WaitGroup
+	conns := make([]*mockConn, 8)
+	errs := make([]error, len(conns))
+	for i := range conns {
+		conns[i] = CreateMockConn(t, srv).(*mockConn)
+		conns[i].user = "max_conn_user"
+		conns[i].peerHost = "127.0.0.1"
+		defer conns[i].Close()
+	}
+	for i, cc := range conns {
+		wg.Add(1)
+		go func(i int, cc *mockConn) {
+			defer wg.Done()
+			errs[i] = cc.openSessionAndDoAuth([]byte{}, mysql.AuthNativePassword, 0)
+		}(i, cc)
+	}
+	wg.Wait()
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else {
+			require.ErrorIs(t, err, servererr.ErrTooManyUserConnections)
+		}
+	}
+	require.Equal(t, 1, succeeded)
+
+	// A connection that fails to change user is not counted for its previous account.
+	data := append([]byte("no_such_user"), 0, 0, 0)
+	require.Error(t, cc5.handleChangeUser(ctx, data))
+	cc6, err := login()
+	require.NoError(t, err)
+	defer cc6.Close()
+}
+
 type snapshotCache interface {
 	SnapCacheHitCount() int
 }
@@ -32,6 +32,8 @@ var (
 	ErrAccessDeniedNoPassword = dbterror.ClassServer.NewStd(errno.ErrAccessDeniedNoPassword)
 	// ErrConCount is returned when too many connections are established by the user.
 	ErrConCount = dbterror.ClassServer.NewStd(errno.ErrConCount)
+	// ErrTooManyUserConnections is returned when the account already has max_user_connections connections.
+	ErrTooManyUserConnections = dbterror.ClassServer.NewStd(errno.ErrTooManyUserConnections)
 	// ErrSecureTransportRequired is returned when the user tries to connect without SSL.
 	ErrSecureTransportRequired = dbterror.ClassServer.NewStd(errno.ErrSecureTransportRequired)
 	// ErrMultiStatementDisabled is returned when the user tries to send multiple statements in one statement.
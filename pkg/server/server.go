@@ -120,6 +120,8 @@ type Server struct {
 	rwlock                 sync.RWMutex
 	clients                map[uint64]*clientConn
 	ConnNumByResourceGroup map[string]int
+	// connNumByAccount counts the authenticated connections of each account for max_user_connections.
+	connNumByAccount map[string]int
 
 	capability uint32
 	dom        *domain.Domain
@@ -242,6 +244,7 @@ func NewServer(cfg *config.Config, driver IDriver) (*Server, error) {
 		concurrentLimiter:      NewTokenLimiter(cfg.TokenLimit),
 		clients:                make(map[uint64]*clientConn),
 		ConnNumByResourceGroup: make(map[string]int),
+		connNumByAccount:       make(map[string]int),
 		internalSessions:       make(map[any]struct{}, 100),
 		health:                 uatomic.NewBool(true),
 		inShutdownMode:         uatomic.NewBool(false),
@@ -788,6 +791,55 @@ func (s *Server) checkConnectionCount() error {
 	return nil
 }
 
+// reserveUserConnection counts cc as a connection of its authenticated account, and returns an error
+// if the account has reached max_user_connections. The connection is counted under the same lock as
+// the other connections of the account, so concurrent logins can not exceed the limit.
+func (s *Server) reserveUserConnection(cc *clientConn) error {
+	user := cc.getCtx().GetSessionVars().User
+	if user == nil {
+		return nil
+	}
+	account := user.AuthUsername + "@" + user.AuthHostname
+	maxUserConns := variable.MaxUserConnectionsValue.Load()
+
+	s.rwlock.Lock()
+	defer s.rwlock.Unlock()
+	if cc.userConnAccount == account {
+		return nil
+	}
+	s.releaseUserConnectionWithoutLock(cc)
+	// When the value of max_user_connections is 0, the number of connections of an account is unlimited.
+	if maxUserConns > 0 && s.connNumByAccount[account] >= int(maxUserConns) {
+		logutil.BgLogger().Warn("too many user connections", zap.String("user", user.String()),
+			zap.Uint32("max user connections", maxUserConns), zap.Error(servererr.ErrTooManyUserConnections))
+		return servererr.ErrTooManyUserConnections.GenWithStackByArgs(user.AuthUsername)
+	}
+	s.connNumByAccount[account]++
+	cc.userConnAccount = account
+	return nil
+}
+
+// releaseUserConnection stops counting cc as a connection of its account, it's idempotent.
+func (s *Server) releaseUserConnection(cc *clientConn) {
+	s.rwlock.Lock()
+	defer s.rwlock.Unlock()
+	s.releaseUserConnectionWithoutLock(cc)
+}
+
+// releaseUserConnectionWithoutLock stops counting cc as a connection of its account, it's idempotent.
+// The caller should hold s.rwlock.
+func (s *Server) releaseUserConnectionWithoutLock(cc *clientConn) {
+	if cc.userConnAccount == "" {
+		return
+	}
+	if s.connNumByAccount[cc.userConnAccount] <= 1 {
+		delete(s.connNumByAccount, cc.userConnAccount)
+	} else {
+		s.connNumByAccount[cc.userConnAccount]--
+	}
+	cc.userConnAccount = ""
+}
+
 // ShowProcessList implements the SessionManager interface.
 func (s *Server) ShowProcessList() map[uint64]*util.ProcessInfo {
 	rs := make(map[uint64]*util.ProcessInfo)
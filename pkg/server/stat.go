@@ -26,15 +26,17 @@ import (
 )
 
 var (
-	serverNotAfter  = "Ssl_server_not_after"
-	serverNotBefore = "Ssl_server_not_before"
-	upTime          = "Uptime"
+	serverNotAfter   = "Ssl_server_not_after"
+	serverNotBefore  = "Ssl_server_not_before"
+	upTime           = "Uptime"
+	threadsConnected = "Threads_connected"
 )
 
 var defaultStatus = map[string]*variable.StatusVal{
-	serverNotAfter:  {Scope: variable.ScopeGlobal | variable.ScopeSession, Value: ""},
-	serverNotBefore: {Scope: variable.ScopeGlobal | variable.ScopeSession, Value: ""},
-	upTime:          {Scope: variable.ScopeGlobal, Value: 0},
+	serverNotAfter:   {Scope: variable.ScopeGlobal | variable.ScopeSession, Value: ""},
+	serverNotBefore:  {Scope: variable.ScopeGlobal | variable.ScopeSession, Value: ""},
+	upTime:           {Scope: variable.ScopeGlobal, Value: 0},
+	threadsConnected: {Scope: variable.ScopeGlobal, Value: 0},
 }
 
 // GetScope gets the Status variables scope.
@@ -50,6 +52,8 @@ func (s *Server) Stats(_ *variable.SessionVars) (map[string]any, error) {
 		m[name] = v.Value
 	}
 
+	m[threadsConnected] = s.ConnectionCount()
+
 	tlsConfig := s.GetTLSConfig()
 	if tlsConfig != nil {
 		if len(tlsConfig.Certificates) == 1 {
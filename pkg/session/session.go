@@ -922,6 +922,7 @@ func (s *session) doCommitWithRetry(ctx context.Context) error {
 		return err
 	}
 	s.updateStatsDeltaToCollector()
+	s.invalidateQueryResultCache()
 	return nil
 }
 
@@ -994,6 +995,21 @@ func (s *session) updateStatsDeltaToCollector() {
 	}
 }
 
+// invalidateQueryResultCache drops the cached results which read any table
+// written by the committed transaction.
+func (s *session) invalidateQueryResultCache() {
+	cache := domain.GetDomain(s).QueryResultCache()
+	mapper := s.GetSessionVars().TxnCtx.TableDeltaMap
+	if cache == nil || len(mapper) == 0 {
+		return
+	}
+	tableIDs := make([]int64, 0, len(mapper))
+	for id := range mapper {
+		tableIDs = append(tableIDs, id)
+	}
+	cache.InvalidateTables(tableIDs...)
+}
+
 func (s *session) CommitTxn(ctx context.Context) error {
 	r, ctx := tracing.StartRegionEx(ctx, "session.CommitTxn")
 	defer r.End()
@@ -3845,6 +3861,7 @@ func (s *session) RefreshTxnCtx(ctx context.Context) error {
 	}
 
 	s.updateStatsDeltaToCollector()
+	s.invalidateQueryResultCache()
 
 	return sessiontxn.NewTxn(ctx, s)
 }
@@ -1214,6 +1214,7 @@ const (
 	DefDMLBatchSize                                = 0
 	DefMaxPreparedStmtCount                        = -1
 	DefWaitTimeout                                 = 28800
+	DefMaxUserConnections                          = 0
 	DefTiDBMemQuotaApplyCache                      = 32 << 20 // 32MB.
 	DefTiDBMemQuotaBindingCache                    = 64 << 20 // 64MB.
 	DefTiDBGeneralLog                              = false
@@ -1515,6 +1516,7 @@ var (
 	StatsCacheMemQuota                   = atomic.NewInt64(DefTiDBStatsCacheMemQuota)
 	OOMAction                            = atomic.NewString(DefTiDBMemOOMAction)
 	MaxAutoAnalyzeTime                   = atomic.NewInt64(DefTiDBMaxAutoAnalyzeTime)
+	MaxUserConnectionsValue              = atomic.NewUint32(DefMaxUserConnections)
 	// variables for plan cache
 	PreparedPlanCacheMemoryGuardRatio = atomic.NewFloat64(DefTiDBPrepPlanCacheMemoryGuardRatio)
 	EnableDistTask                    = atomic.NewBool(DefTiDBEnableDistTask)
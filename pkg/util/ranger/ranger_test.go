@@ -228,6 +228,24 @@ func TestTableRange(t *testing.T) {
 			filterConds: "[]",
 			resultStr:   "[[-inf,1) (3,+inf]]",
 		},
+		{
+			exprStr:     "a > '10'",
+			accessConds: "[gt(test.t.a, 10)]",
+			filterConds: "[]",
+			resultStr:   "[(10,+inf]]",
+		},
+		{
+			exprStr:     "a in ('10', '9', '100')",
+			accessConds: "[in(test.t.a, 10, 9, 100)]",
+			filterConds: "[]",
+			resultStr:   "[[9,9] [10,10] [100,100]]",
+		},
+		{
+			exprStr:     "a >= '9' and a < '10'",
+			accessConds: "[ge(test.t.a, 9) lt(test.t.a, 10)]",
+			filterConds: "[]",
+			resultStr:   "[[9,10)]",
+		},
 		{
 			exprStr:     "a > 9223372036854775807",
 			accessConds: "[gt(test.t.a, 9223372036854775807)]",
@@ -730,6 +748,31 @@ func TestColumnRange(t *testing.T) {
 			resultStr:   "[[NULL,0) (0,+inf]]",
 			length:      types.UnspecifiedLength,
 		},
+		{
+			colPos:      2,
+			exprStr:     "c IS TRUE",
+			accessConds: "[istrue(test.t.c)]",
+			filterConds: "[]",
+			resultStr:   "[[-inf,0) (0,+inf]]",
+			length:      types.UnspecifiedLength,
+		},
+		{
+			colPos:      2,
+			exprStr:     "c IS FALSE",
+			accessConds: "[isfalse(test.t.c)]",
+			filterConds: "[]",
+			resultStr:   "[[0,0]]",
+			length:      types.UnspecifiedLength,
+		},
+		{
+			// The truth of a string depends on its numeric prefix, so no range is built.
+			colPos:      3,
+			exprStr:     "d IS TRUE",
+			accessConds: "[]",
+			filterConds: "[istrue(cast(test.t.d, double BINARY))]",
+			resultStr:   "[[NULL,+inf]]",
+			length:      types.UnspecifiedLength,
+		},
 		{
 			colPos:      1,
 			exprStr:     `b in (1, '2.1')`,
@@ -1107,6 +1150,20 @@ create table t(
 		filterConds string
 		resultStr   string
 	}{
+		{
+			indexPos:    0,
+			exprStr:     "a = 'x' and b is true",
+			accessConds: "[eq(test.t.a, x) istrue(test.t.b)]",
+			filterConds: "[]",
+			resultStr:   "[[\"x\" -inf,\"x\" 0) (\"x\" 0,\"x\" +inf]]",
+		},
+		{
+			indexPos:    1,
+			exprStr:     "c is false and a = 'x'",
+			accessConds: "[isfalse(test.t.c) eq(test.t.a, x)]",
+			filterConds: "[]",
+			resultStr:   "[[0 \"x\",0 \"x\"]]",
+		},
 		{
 			indexPos:    0,
 			exprStr:     `a LIKE 'abc%'`,
@@ -0,0 +1,205 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resultcache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+const (
+	// MaxRows is the maximum number of rows of a result that can be cached.
+	MaxRows = 1024
+	// MaxEntryMemory is the maximum memory usage in bytes of a result that can
+	// be cached, it's also bounded by the memory limit of the whole cache.
+	MaxEntryMemory = 1 << 20
+)
+
+// Key identifies a cached result. A schema change bumps SchemaVersion, so
+// results computed against an older schema can never be hit again.
+type Key struct {
+	// SQLDigest is the digest of the restored SQL text, together with the
+	// session state the result depends on. Unlike the digest of the
+	// normalized SQL, it keeps the literals of the query.
+	SQLDigest     string
+	SchemaVersion int64
+}
+
+// entry is the value of list.Element.
+type entry struct {
+	key      Key
+	tableIDs []int64
+	rows     *chunk.Chunk
+	memUsage int64
+	expireAt time.Time
+}
+
+// Cache is a bounded, thread-safe cache of query results. Entries expire
+// after a short TTL and are dropped as soon as any table they read is written
+// by this TiDB instance. Writes from other TiDB instances are not observed, so
+// the staleness of a result is only bounded by the TTL.
+type Cache struct {
+	mu       sync.Mutex
+	capacity int
+	memLimit int64
+	memUsage int64
+	ttl      time.Duration
+	elements map[Key]*list.Element
+	// tables maps a table ID to the keys of all entries reading that table.
+	tables map[int64]map[Key]struct{}
+	lru    *list.List
+	// epoch is increased by every InvalidateTables.
+	epoch uint64
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// New creates a Cache holding at most capacity results using at most memLimit
+// bytes in total, each for at most ttl.
+// NOTE: "capacity", "memLimit" and "ttl" should be positive values.
+func New(capacity int, memLimit int64, ttl time.Duration) *Cache {
+	if capacity < 1 {
+		panic("capacity of result cache should be at least 1.")
+	}
+	if memLimit < 1 {
+		panic("memory limit of result cache should be at least 1.")
+	}
+	return &Cache{
+		capacity: capacity,
+		memLimit: memLimit,
+		ttl:      ttl,
+		elements: make(map[Key]*list.Element),
+		tables:   make(map[int64]map[Key]struct{}),
+		lru:      list.New(),
+		now:      time.Now,
+	}
+}
+
+// Get returns the cached rows of key. The returned chunk is shared with the
+// cache and must not be modified.
+func (c *Cache) Get(key Key) (*chunk.Chunk, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.elements[key]
+	if !ok {
+		return nil, false
+	}
+	e := elem.Value.(*entry)
+	if !c.now().Before(e.expireAt) {
+		c.removeElement(elem)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return e.rows, true
+}
+
+// Epoch returns the number of invalidations so far. It should be read before
+// the query starts to read data, and passed to Put.
+func (c *Cache) Epoch() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.epoch
+}
+
+// EntryMemoryLimit returns the maximum memory usage in bytes of a result that
+// can be cached.
+func (c *Cache) EntryMemoryLimit() int64 {
+	return min(MaxEntryMemory, c.memLimit)
+}
+
+// Put stores the rows of key, which was computed by reading the physical
+// tables tableIDs. The cache takes the ownership of rows. Nothing is stored if
+// any table was invalidated since epoch was read, because the rows may have
+// been read before that write was committed, or if the rows use more memory
+// than EntryMemoryLimit.
+func (c *Cache) Put(key Key, epoch uint64, tableIDs []int64, rows *chunk.Chunk) {
+	memUsage := rows.MemoryUsage()
+	if memUsage > c.EntryMemoryLimit() {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if epoch != c.epoch {
+		return
+	}
+	if elem, ok := c.elements[key]; ok {
+		c.removeElement(elem)
+	}
+	e := &entry{
+		key:      key,
+		tableIDs: tableIDs,
+		rows:     rows,
+		memUsage: memUsage,
+		expireAt: c.now().Add(c.ttl),
+	}
+	c.elements[key] = c.lru.PushFront(e)
+	c.memUsage += memUsage
+	for _, id := range tableIDs {
+		keys, ok := c.tables[id]
+		if !ok {
+			keys = make(map[Key]struct{})
+			c.tables[id] = keys
+		}
+		keys[key] = struct{}{}
+	}
+	for c.lru.Len() > c.capacity || c.memUsage > c.memLimit {
+		c.removeElement(c.lru.Back())
+	}
+}
+
+// InvalidateTables drops every entry that read any of tableIDs. It should be
+// called once a write to those tables is committed.
+func (c *Cache) InvalidateTables(tableIDs ...int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.epoch++
+	for _, id := range tableIDs {
+		for key := range c.tables[id] {
+			c.removeElement(c.elements[key])
+		}
+	}
+}
+
+// Len returns the number of cached results, including expired ones that have
+// not been evicted yet.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// MemoryUsage returns the memory usage in bytes of the cached results.
+func (c *Cache) MemoryUsage() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.memUsage
+}
+
+func (c *Cache) removeElement(elem *list.Element) {
+	e := c.lru.Remove(elem).(*entry)
+	delete(c.elements, e.key)
+	c.memUsage -= e.memUsage
+	for _, id := range e.tableIDs {
+		keys := c.tables[id]
+		delete(keys, e.key)
+		if len(keys) == 0 {
+			delete(c.tables, id)
+		}
+	}
+}
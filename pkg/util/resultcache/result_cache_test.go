@@ -0,0 +1,176 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resultcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/stretchr/testify/require"
+)
+
+func newRows(vals ...int64) *chunk.Chunk {
+	chk := chunk.NewChunkWithCapacity([]*types.FieldType{types.NewFieldType(mysql.TypeLonglong)}, len(vals))
+	for _, v := range vals {
+		chk.AppendInt64(0, v)
+	}
+	return chk
+}
+
+func TestHitAndInvalidate(t *testing.T) {
+	c := New(10, 1<<20, time.Minute)
+	k1 := Key{SQLDigest: "select * from t1", SchemaVersion: 1}
+	k2 := Key{SQLDigest: "select * from t2", SchemaVersion: 1}
+	c.Put(k1, c.Epoch(), []int64{1}, newRows(1, 2, 3))
+	c.Put(k2, c.Epoch(), []int64{2}, newRows(4))
+
+	rows, ok := c.Get(k1)
+	require.True(t, ok)
+	require.Equal(t, 3, rows.NumRows())
+	for i, v := range []int64{1, 2, 3} {
+		require.Equal(t, v, rows.GetRow(i).GetInt64(0))
+	}
+
+	// A different schema version never hits.
+	_, ok = c.Get(Key{SQLDigest: k1.SQLDigest, SchemaVersion: 2})
+	require.False(t, ok)
+
+	// A write to t1 only drops the results reading t1.
+	c.InvalidateTables(1)
+	_, ok = c.Get(k1)
+	require.False(t, ok)
+	_, ok = c.Get(k2)
+	require.True(t, ok)
+	require.Equal(t, 1, c.Len())
+
+	c.InvalidateTables(3)
+	require.Equal(t, 1, c.Len())
+}
+
+func TestJoinInvalidate(t *testing.T) {
+	c := New(10, 1<<20, time.Minute)
+	k := Key{SQLDigest: "select * from t1 join t2", SchemaVersion: 1}
+	c.Put(k, c.Epoch(), []int64{1, 2}, newRows(1))
+	c.InvalidateTables(2)
+	_, ok := c.Get(k)
+	require.False(t, ok)
+	require.Empty(t, c.tables)
+}
+
+func TestTTL(t *testing.T) {
+	c := New(10, 1<<20, time.Second)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	k := Key{SQLDigest: "select 1", SchemaVersion: 1}
+	c.Put(k, c.Epoch(), nil, newRows(1))
+
+	now = now.Add(999 * time.Millisecond)
+	_, ok := c.Get(k)
+	require.True(t, ok)
+
+	now = now.Add(time.Millisecond)
+	_, ok = c.Get(k)
+	require.False(t, ok)
+	require.Equal(t, 0, c.Len())
+}
+
+func TestCapacity(t *testing.T) {
+	c := New(2, 1<<20, time.Minute)
+	k1 := Key{SQLDigest: "q1"}
+	k2 := Key{SQLDigest: "q2"}
+	k3 := Key{SQLDigest: "q3"}
+	c.Put(k1, c.Epoch(), []int64{1}, newRows(1))
+	c.Put(k2, c.Epoch(), []int64{1}, newRows(2))
+	// Touch k1 so that k2 becomes the least recently used one.
+	_, ok := c.Get(k1)
+	require.True(t, ok)
+	c.Put(k3, c.Epoch(), []int64{1}, newRows(3))
+
+	require.Equal(t, 2, c.Len())
+	_, ok = c.Get(k2)
+	require.False(t, ok)
+	_, ok = c.Get(k1)
+	require.True(t, ok)
+	_, ok = c.Get(k3)
+	require.True(t, ok)
+
+	// Overwriting a key keeps a single entry.
+	c.Put(k3, c.Epoch(), []int64{2}, newRows(4))
+	require.Equal(t, 2, c.Len())
+	c.InvalidateTables(1)
+	rows, ok := c.Get(k3)
+	require.True(t, ok)
+	require.Equal(t, int64(4), rows.GetRow(0).GetInt64(0))
+}
+
+func TestPutAfterInvalidate(t *testing.T) {
+	c := New(10, 1<<20, time.Minute)
+	k := Key{SQLDigest: "select * from t1", SchemaVersion: 1}
+	// The rows are read before a write to any table is committed.
+	epoch := c.Epoch()
+	c.InvalidateTables(1)
+	c.Put(k, epoch, []int64{1}, newRows(1))
+	_, ok := c.Get(k)
+	require.False(t, ok)
+	require.Equal(t, 0, c.Len())
+
+	c.Put(k, c.Epoch(), []int64{1}, newRows(1))
+	_, ok = c.Get(k)
+	require.True(t, ok)
+}
+
+func TestPartitionInvalidate(t *testing.T) {
+	c := New(10, 1<<20, time.Minute)
+	// The physical IDs of the table and all its partitions are recorded.
+	k := Key{SQLDigest: "select * from pt", SchemaVersion: 1}
+	c.Put(k, c.Epoch(), []int64{1, 11, 12}, newRows(1))
+	c.InvalidateTables(12)
+	_, ok := c.Get(k)
+	require.False(t, ok)
+	require.Empty(t, c.tables)
+}
+
+func TestMemoryLimit(t *testing.T) {
+	rowsMemUsage := newRows(1).MemoryUsage()
+	c := New(10, 2*rowsMemUsage, time.Minute)
+	require.Equal(t, 2*rowsMemUsage, c.EntryMemoryLimit())
+	k1 := Key{SQLDigest: "q1"}
+	k2 := Key{SQLDigest: "q2"}
+	k3 := Key{SQLDigest: "q3"}
+	c.Put(k1, c.Epoch(), []int64{1}, newRows(1))
+	c.Put(k2, c.Epoch(), []int64{1}, newRows(2))
+	require.Equal(t, 2*rowsMemUsage, c.MemoryUsage())
+	// The least recently used result is evicted once the memory limit is exceeded.
+	c.Put(k3, c.Epoch(), []int64{1}, newRows(3))
+	require.Equal(t, 2, c.Len())
+	require.Equal(t, 2*rowsMemUsage, c.MemoryUsage())
+	_, ok := c.Get(k1)
+	require.False(t, ok)
+
+	// A result larger than the limit of an entry is not stored.
+	large := newRows(make([]int64, 1024)...)
+	require.Greater(t, large.MemoryUsage(), c.EntryMemoryLimit())
+	c.Put(k1, c.Epoch(), []int64{1}, large)
+	_, ok = c.Get(k1)
+	require.False(t, ok)
+	require.Equal(t, 2, c.Len())
+
+	c.InvalidateTables(1)
+	require.Equal(t, int64(0), c.MemoryUsage())
+	require.Equal(t, int64(MaxEntryMemory), New(10, 1<<30, time.Minute).EntryMemoryLimit())
+}